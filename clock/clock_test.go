@@ -0,0 +1,85 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigure(t *testing.T) {
+	defer Set(System)
+	defer SetLocation(time.Local)
+
+	testCases := []struct {
+		name          string
+		zone          string
+		freeze        string
+		shift         string
+		expectedToday time.Time
+		expectedError string
+	}{
+		{
+			name:          "Frozen date",
+			zone:          "UTC",
+			freeze:        "2023-08-31",
+			expectedToday: time.Date(2023, time.August, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "Frozen and shifted by days",
+			zone:          "UTC",
+			freeze:        "2023-08-31",
+			shift:         "-30d",
+			expectedToday: time.Date(2023, time.August, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "Time zone decides the date",
+			zone:          "Asia/Tokyo",
+			freeze:        "2023-08-31T20:00:00Z",
+			expectedToday: time.Date(2023, time.September, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "Shifted by a duration",
+			zone:          "America/Chicago",
+			freeze:        "2023-09-01T03:00:00Z",
+			shift:         "+24h",
+			expectedToday: time.Date(2023, time.September, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "Unknown time zone",
+			zone:          "Mars/Olympus",
+			expectedError: "invalid time zone: Mars/Olympus",
+		},
+		{
+			name:          "Unreadable frozen time",
+			freeze:        "8/31/2023",
+			expectedError: "invalid frozen time: use 2006-01-02 or RFC 3339",
+		},
+		{
+			name:          "Unreadable shift",
+			shift:         "a week",
+			expectedError: "invalid clock shift: a week",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Configure(tc.zone, tc.freeze, tc.shift)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedToday, Today())
+		})
+	}
+}
+
+func TestSystemClock(t *testing.T) {
+	defer SetLocation(time.Local)
+	SetLocation(time.UTC)
+
+	assert.WithinDuration(t, time.Now(), Now(), time.Second)
+	assert.Equal(t, time.UTC, Now().Location())
+}
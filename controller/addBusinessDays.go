@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"date_calculation/holiday"
+	"date_calculation/models"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+const maxBusinessDays = 100000
+
+func AddBusinessDays(context *gin.Context) {
+	var input models.InputAddBusinessDays
+	var output models.OutputResults
+
+	handleError := func(status int, errorString string) {
+		output.ErrorFlag = "HTTP " + strconv.Itoa(status)
+		output.ErrorText = errorString
+		context.JSON(status, gin.H{"results": output})
+	}
+
+	if err := context.ShouldBindJSON(&input); err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	options, err := newConversionOptions(input.ConversionOptions)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if input.Days < -maxBusinessDays || input.Days > maxBusinessDays {
+		handleError(http.StatusBadRequest, "business days out of range: must be between -"+
+			strconv.Itoa(maxBusinessDays)+" and "+strconv.Itoa(maxBusinessDays))
+		return
+	}
+
+	sourceDate, err := resolveDate(input.Date, input.DateOptions, options)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resultDate, skipped := options.calendar.AddBusinessDays(sourceDate, input.Days, options.weekend)
+	if resultDate.Year() < 1 || resultDate.Year() > 9999 {
+		handleError(http.StatusBadRequest, "result out of range: must be between 0001-01-01 and 9999-12-31")
+		return
+	}
+
+	output = calcDatesByCalendarDate(resultDate.Format("1/2/2006"), options)
+	source := calcDatesByCalendarDate(sourceDate.Format("1/2/2006"), options)
+
+	context.IndentedJSON(http.StatusOK, withAdjusted(gin.H{
+		"results": output,
+		"source":  source,
+		"skipped": nonWorkingDays(skipped),
+	}, resultDate.Format("1/2/2006"), options))
+}
+
+func nonWorkingDays(days []holiday.NonWorkingDay) []models.NonWorkingDay {
+	output := make([]models.NonWorkingDay, len(days))
+	for i, day := range days {
+		output[i] = models.NonWorkingDay{
+			Date:        day.Date.Format("2006-01-02"),
+			HundredYear: strconv.Itoa(hundredYearDay(day.Date)),
+			Reason:      day.Reason,
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddBusinessDays(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/AddBusinessDays", AddBusinessDays)
+
+	testCases := []struct {
+		name              string
+		payload           string
+		expectedStatus    int
+		expectedResult    string
+		expectedHundred   string
+		expectedSkipped   []models.NonWorkingDay
+		expectedErrorText string
+	}{
+		{
+			name:            "Ten business days after receipt",
+			payload:         `{"date": "2023-12-20", "days": 10}`,
+			expectedStatus:  http.StatusOK,
+			expectedResult:  "2024-01-05",
+			expectedHundred: "45295",
+			expectedSkipped: []models.NonWorkingDay{
+				{Date: "2023-12-23", HundredYear: "45282", Reason: "Saturday"},
+				{Date: "2023-12-24", HundredYear: "45283", Reason: "Sunday"},
+				{Date: "2023-12-25", HundredYear: "45284", Reason: "Christmas Day"},
+				{Date: "2023-12-30", HundredYear: "45289", Reason: "Saturday"},
+				{Date: "2023-12-31", HundredYear: "45290", Reason: "Sunday"},
+				{Date: "2024-01-01", HundredYear: "45291", Reason: "New Year's Day"},
+			},
+		},
+		{
+			name:            "Backward with the UK calendar",
+			payload:         `{"date": "2024-04-02", "days": -1, "calendar": "UK"}`,
+			expectedStatus:  http.StatusOK,
+			expectedResult:  "2024-03-28",
+			expectedHundred: "45378",
+			expectedSkipped: []models.NonWorkingDay{
+				{Date: "2024-04-01", HundredYear: "45382", Reason: "Easter Monday"},
+				{Date: "2024-03-31", HundredYear: "45381", Reason: "Sunday"},
+				{Date: "2024-03-30", HundredYear: "45380", Reason: "Saturday"},
+				{Date: "2024-03-29", HundredYear: "45379", Reason: "Good Friday"},
+			},
+		},
+		{
+			name:            "Friday and Saturday weekend",
+			payload:         `{"date": "45189", "format": "*HYD", "days": 1, "calendar": "NONE", "weekend": "FRI,SAT"}`,
+			expectedStatus:  http.StatusOK,
+			expectedResult:  "2023-09-24",
+			expectedHundred: "45192",
+			expectedSkipped: []models.NonWorkingDay{
+				{Date: "2023-09-22", HundredYear: "45190", Reason: "Friday"},
+				{Date: "2023-09-23", HundredYear: "45191", Reason: "Saturday"},
+			},
+		},
+		{
+			name:              "Invalid weekend",
+			payload:           `{"date": "2023-09-21", "days": 1, "weekend": "SAT,XYZ"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid weekend day: XYZ",
+		},
+		{
+			name:              "Too many days",
+			payload:           `{"date": "2023-09-21", "days": 100001}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "business days out of range: must be between -100000 and 100000",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/AddBusinessDays", strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+
+			var responseWrapper struct {
+				Results models.OutputResults   `json:"results"`
+				Skipped []models.NonWorkingDay `json:"skipped"`
+			}
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, responseWrapper.Results.InternationalStandard)
+			assert.Equal(t, tc.expectedHundred, responseWrapper.Results.AcscHundredYear)
+			assert.Equal(t, tc.expectedSkipped, responseWrapper.Skipped)
+			assert.Equal(t, tc.expectedErrorText, responseWrapper.Results.ErrorText)
+		})
+	}
+}
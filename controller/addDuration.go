@@ -0,0 +1,121 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func AddDuration(context *gin.Context) {
+	var input models.InputAddDuration
+	var output models.OutputResults
+
+	handleError := func(status int, errorString string) {
+		output.ErrorFlag = "HTTP " + strconv.Itoa(status)
+		output.ErrorText = errorString
+		context.JSON(status, gin.H{"results": output})
+	}
+
+	if err := context.ShouldBindJSON(&input); err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	options, err := newConversionOptions(input.ConversionOptions)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	sourceDate, err := resolveDate(input.Date, input.DateOptions, options)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resultDate, err := addDuration(sourceDate, input.Years, input.Months, input.Days, input.Overflow)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	output = calcDatesByCalendarDate(resultDate.Format("1/2/2006"), options)
+	source := calcDatesByCalendarDate(sourceDate.Format("1/2/2006"), options)
+
+	context.IndentedJSON(http.StatusOK, withAdjusted(gin.H{"results": output, "source": source},
+		resultDate.Format("1/2/2006"), options))
+}
+
+// Longer than any span between 0001 and 9999, short enough that adding
+// them up cannot overflow
+const (
+	maxDurationMonths = 120000
+	maxDurationDays   = 3660000
+)
+
+// Years and months are applied together, then days, the way RPG chains
+// %YEARS, %MONTHS and %DAYS
+func addDuration(date time.Time, years int, months int, days int, overflow string) (time.Time, error) {
+	if years < -maxDurationMonths/12 || years > maxDurationMonths/12 ||
+		months < -maxDurationMonths || months > maxDurationMonths ||
+		days < -maxDurationDays || days > maxDurationDays {
+		return time.Time{}, errors.New("duration out of range: must be less than 10000 years")
+	}
+
+	resultDate, err := addMonths(date, years*12+months, overflow)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	resultDate = resultDate.AddDate(0, 0, days)
+	if resultDate.Year() < 1 || resultDate.Year() > 9999 {
+		return time.Time{}, errors.New("result out of range: must be between 0001-01-01 and 9999-12-31")
+	}
+
+	return resultDate, nil
+}
+
+// Overflow policies for a day of month the target month does not have:
+// *CLAMP uses the month end like IBM i, *EOM also keeps month ends on the
+// month end, *ROLL spills into the next month and *ERROR rejects it
+func addMonths(date time.Time, months int, overflow string) (time.Time, error) {
+	overflow = strings.ToUpper(overflow)
+	if overflow != "" && overflow != "*CLAMP" && overflow != "*EOM" && overflow != "*ROLL" && overflow != "*ERROR" {
+		return time.Time{}, errors.New("invalid overflow: must be *CLAMP, *EOM, *ROLL or *ERROR")
+	}
+
+	if months == 0 {
+		return date, nil
+	}
+
+	firstOfTarget := time.Date(date.Year(), date.Month()+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	lastDay := daysInMonth(firstOfTarget.Year(), firstOfTarget.Month())
+
+	day := date.Day()
+	switch overflow {
+	case "", "*CLAMP":
+		day = min(day, lastDay)
+	case "*EOM":
+		if day == daysInMonth(date.Year(), date.Month()) || day > lastDay {
+			day = lastDay
+		}
+	case "*ROLL":
+		return date.AddDate(0, months, 0), nil
+	case "*ERROR":
+		if day > lastDay {
+			return time.Time{}, errors.New("date overflow: " + firstOfTarget.Format("January 2006") +
+				" has no day " + strconv.Itoa(day))
+		}
+	}
+
+	return time.Date(firstOfTarget.Year(), firstOfTarget.Month(), day, 0, 0, 0, 0, time.UTC), nil
+}
+
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,152 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddDuration(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/AddDuration", AddDuration)
+
+	testCases := []struct {
+		name              string
+		payload           string
+		expectedStatus    int
+		expectedResult    string
+		expectedSource    string
+		expectedErrorText string
+	}{
+		{
+			name:           "Plus 90 days",
+			payload:        `{"date": "2023-08-31", "days": 90}`,
+			expectedStatus: http.StatusOK,
+			expectedResult: "2023-11-29",
+			expectedSource: "2023-08-31",
+		},
+		{
+			name:           "Month first date with a day of 12 or less",
+			payload:        `{"date": "1/2/2023", "months": 1}`,
+			expectedStatus: http.StatusOK,
+			expectedResult: "2023-02-02",
+			expectedSource: "2023-01-02",
+		},
+		{
+			name:           "Minus 6 months",
+			payload:        `{"date": "2023-08-31", "months": -6}`,
+			expectedStatus: http.StatusOK,
+			expectedResult: "2023-02-28",
+			expectedSource: "2023-08-31",
+		},
+		{
+			name:           "Month end clamps into a leap February",
+			payload:        `{"date": "2024-01-31", "months": 1}`,
+			expectedStatus: http.StatusOK,
+			expectedResult: "2024-02-29",
+			expectedSource: "2024-01-31",
+		},
+		{
+			name:           "Leap day plus one year",
+			payload:        `{"date": "2024-02-29", "years": 1}`,
+			expectedStatus: http.StatusOK,
+			expectedResult: "2025-02-28",
+			expectedSource: "2024-02-29",
+		},
+		{
+			name:           "Month end stays on month end",
+			payload:        `{"date": "2023-02-28", "months": 1, "overflow": "*EOM"}`,
+			expectedStatus: http.StatusOK,
+			expectedResult: "2023-03-31",
+			expectedSource: "2023-02-28",
+		},
+		{
+			name:           "Roll into the next month",
+			payload:        `{"date": "2023-01-31", "months": 1, "overflow": "*ROLL"}`,
+			expectedStatus: http.StatusOK,
+			expectedResult: "2023-03-03",
+			expectedSource: "2023-01-31",
+		},
+		{
+			name:              "Overflow rejected",
+			payload:           `{"date": "2023-01-31", "months": 1, "overflow": "*ERROR"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "date overflow: February 2023 has no day 31",
+		},
+		{
+			name:           "HYD input with HYD offset",
+			payload:        `{"date": "44926", "format": "*HYD", "days": -1}`,
+			expectedStatus: http.StatusOK,
+			expectedResult: "2022-12-31",
+			expectedSource: "2023-01-01",
+		},
+		{
+			name:           "CVTDAT format input",
+			payload:        `{"date": "1230831", "format": "*CYMD", "years": 1, "months": 1, "days": 1}`,
+			expectedStatus: http.StatusOK,
+			expectedResult: "2024-10-01",
+			expectedSource: "2023-08-31",
+		},
+		{
+			name:           "Ambiguous input resolved by order",
+			payload:        `{"date": "01/02/03", "order": "DMY", "days": 1}`,
+			expectedStatus: http.StatusOK,
+			expectedResult: "2003-02-02",
+			expectedSource: "2003-02-01",
+		},
+		{
+			name:              "Ambiguous input",
+			payload:           `{"date": "01/02/03", "days": 1}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "ambiguous date: 01/02/03 matches *MDY, *DMY, *YMD",
+		},
+		{
+			name:              "Result out of range",
+			payload:           `{"date": "9999-12-31", "days": 1}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "result out of range: must be between 0001-01-01 and 9999-12-31",
+		},
+		{
+			name:              "Years that would overflow the month count",
+			payload:           `{"date": "2023-01-31", "years": 768614336404564651}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "duration out of range: must be less than 10000 years",
+		},
+		{
+			name:              "Unknown overflow policy",
+			payload:           `{"date": "2023-08-31", "days": 1, "overflow": "*WRAP"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid overflow: must be *CLAMP, *EOM, *ROLL or *ERROR",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/AddDuration", strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+
+			var responseWrapper struct {
+				Results models.OutputResults `json:"results"`
+				Source  models.OutputResults `json:"source"`
+			}
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, responseWrapper.Results.InternationalStandard)
+			assert.Equal(t, tc.expectedSource, responseWrapper.Source.InternationalStandard)
+			assert.Equal(t, tc.expectedErrorText, responseWrapper.Results.ErrorText)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func Age(context *gin.Context) {
+	var input models.InputAge
+	var output models.OutputAge
+
+	handleError := func(status int, errorString string) {
+		output.ErrorFlag = "HTTP " + strconv.Itoa(status)
+		output.ErrorText = errorString
+		context.JSON(status, gin.H{"results": output})
+	}
+
+	if err := context.ShouldBindJSON(&input); err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	options, err := newConversionOptions(input.ConversionOptions)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	leapDay := strings.ToUpper(strings.TrimSpace(input.LeapDay))
+	if leapDay == "" {
+		leapDay = "*FEB28"
+	}
+	if leapDay != "*FEB28" && leapDay != "*MAR1" {
+		handleError(http.StatusBadRequest, "invalid leapDay: must be *FEB28 or *MAR1")
+		return
+	}
+
+	startDate, err := resolveDate(input.Date, input.DateOptions, options)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	asOfDate, err := resolveAsOfDate(input, options)
+	if err != nil {
+		handleError(http.StatusBadRequest, "asOf: "+err.Error())
+		return
+	}
+
+	if asOfDate.Before(startDate) {
+		handleError(http.StatusBadRequest, "invalid asOf: must not be before the date")
+		return
+	}
+
+	output = calcAge(startDate, asOfDate, leapDay)
+
+	response := gin.H{
+		"results": output,
+		"date":    calcDatesByCalendarDate(startDate.Format("1/2/2006"), options),
+		"asOf":    calcDatesByCalendarDate(asOfDate.Format("1/2/2006"), options),
+	}
+	if output.NextAnniversary != "" {
+		nextAnniversary, _ := time.Parse("2006-01-02", output.NextAnniversary)
+		response["anniversary"] = calcDatesByCalendarDate(nextAnniversary.Format("1/2/2006"), options)
+	}
+
+	context.IndentedJSON(http.StatusOK, response)
+}
+
+// The reference date: the job date when not given, otherwise any date
+// CalcCalendarDate reads, relative expressions included
+func resolveAsOfDate(input models.InputAge, options conversionOptions) (time.Time, error) {
+	anchor, _ := jobDate(strings.TrimSpace(input.Session))
+	if strings.TrimSpace(input.AsOf) == "" {
+		return anchor, nil
+	}
+
+	asOfDate, err := resolveDate(input.AsOf, input.DateOptions, options)
+	if err == nil {
+		return asOfDate, nil
+	}
+
+	result, relativeErr := newRelativeParser(anchor, input.DateOptions, options).Parse(input.AsOf)
+	if relativeErr != nil {
+		return time.Time{}, err
+	}
+
+	return result.Date, nil
+}
+
+// Years are completed anniversaries under the leap day policy. Months and
+// days count on the way DateDiff does, from the date itself, or under *MAR1
+// from the last anniversary so a Mar 1 anniversary starts again at zero.
+func calcAge(startDate time.Time, asOfDate time.Time, leapDay string) models.OutputAge {
+	var output models.OutputAge
+
+	years := asOfDate.Year() - startDate.Year()
+	if anniversary(startDate, asOfDate.Year(), leapDay).After(asOfDate) {
+		years--
+	}
+
+	base := startDate
+	if leapDay == "*MAR1" {
+		base = anniversary(startDate, startDate.Year()+years, leapDay)
+	}
+	baseYears := years - (base.Year() - startDate.Year())
+
+	// Feb 28 of a common year falls short of a *MAR1 anniversary, though
+	// clamping months from Feb 29 reaches it
+	clampedYears, months, days := calcCalendarDifference(base, asOfDate)
+	if clampedYears > baseYears {
+		months = 11
+		lastMonth, _ := addMonths(base, baseYears*12+months, "*CLAMP")
+		days = hundredYearDay(asOfDate) - hundredYearDay(lastMonth)
+	}
+
+	output.AsOf = asOfDate.Format("2006-01-02")
+	output.Days = days
+	output.LeapDay = leapDay
+	output.Months = months
+	output.TotalDays = hundredYearDay(asOfDate) - hundredYearDay(startDate)
+	output.Years = years
+	output.ErrorFlag = "0"
+
+	for year := asOfDate.Year(); year <= asOfDate.Year()+1 && year <= 9999; year++ {
+		next := anniversary(startDate, year, leapDay)
+		if next.Before(asOfDate) || year == startDate.Year() {
+			continue
+		}
+
+		output.AnniversaryYears = year - startDate.Year()
+		output.DaysToAnniversary = hundredYearDay(next) - hundredYearDay(asOfDate)
+		output.NextAnniversary = next.Format("2006-01-02")
+		output.NextAnniversaryHundredYear = strconv.Itoa(hundredYearDay(next))
+		break
+	}
+
+	return output
+}
+
+// The day an anniversary of date falls on in year; Feb 29 moves to Feb 28
+// or Mar 1 in common years
+func anniversary(date time.Time, year int, leapDay string) time.Time {
+	if date.Month() == time.February && date.Day() == 29 && !isLeapYear(year) {
+		if leapDay == "*MAR1" {
+			return time.Date(year, time.March, 1, 0, 0, 0, 0, time.UTC)
+		}
+		return time.Date(year, time.February, 28, 0, 0, 0, 0, time.UTC)
+	}
+
+	return time.Date(year, date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,144 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAge(t *testing.T) {
+	freezeClock(t, time.Date(2023, time.August, 31, 12, 0, 0, 0, time.UTC))
+
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/Age", Age)
+
+	testCases := []struct {
+		name           string
+		payload        string
+		expectedStatus int
+		expectedValues models.OutputAge
+	}{
+		{
+			name:           "As of the job date",
+			payload:        `{"date": "1990-01-31"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputAge{
+				AnniversaryYears: 34, AsOf: "2023-08-31", DaysToAnniversary: 153, ErrorFlag: "0", LeapDay: "*FEB28",
+				Months: 7, NextAnniversary: "2024-01-31", NextAnniversaryHundredYear: "45321", TotalDays: 12265, Years: 33,
+			},
+		},
+		{
+			name:           "Short month counted like DateDiff",
+			payload:        `{"date": "1990-01-31", "asOf": "2023-03-15"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputAge{
+				AnniversaryYears: 34, AsOf: "2023-03-15", Days: 15, DaysToAnniversary: 322, ErrorFlag: "0", LeapDay: "*FEB28",
+				Months: 1, NextAnniversary: "2024-01-31", NextAnniversaryHundredYear: "45321", TotalDays: 12096, Years: 33,
+			},
+		},
+		{
+			name:           "Leap day anniversary on Feb 28",
+			payload:        `{"date": "2020-02-29", "asOf": "2023-02-28"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputAge{
+				AnniversaryYears: 3, AsOf: "2023-02-28", ErrorFlag: "0", LeapDay: "*FEB28",
+				NextAnniversary: "2023-02-28", NextAnniversaryHundredYear: "44984", TotalDays: 1095, Years: 3,
+			},
+		},
+		{
+			name:           "Leap day anniversary not yet reached on Feb 28",
+			payload:        `{"date": "2020-02-29", "asOf": "2023-02-28", "leapDay": "*mar1"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputAge{
+				AnniversaryYears: 3, AsOf: "2023-02-28", Days: 27, DaysToAnniversary: 1, ErrorFlag: "0", LeapDay: "*MAR1",
+				Months: 11, NextAnniversary: "2023-03-01", NextAnniversaryHundredYear: "44985", TotalDays: 1095, Years: 2,
+			},
+		},
+		{
+			name:           "Leap day in its first year",
+			payload:        `{"date": "2020-02-29", "asOf": "2021-02-28", "leapDay": "*MAR1"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputAge{
+				AnniversaryYears: 1, AsOf: "2021-02-28", Days: 30, DaysToAnniversary: 1, ErrorFlag: "0", LeapDay: "*MAR1",
+				Months: 11, NextAnniversary: "2021-03-01", NextAnniversaryHundredYear: "44255", TotalDays: 365,
+			},
+		},
+		{
+			name:           "Leap day anniversary on Mar 1",
+			payload:        `{"date": "2020-02-29", "asOf": "2023-03-01", "leapDay": "*MAR1"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputAge{
+				AnniversaryYears: 3, AsOf: "2023-03-01", ErrorFlag: "0", LeapDay: "*MAR1",
+				NextAnniversary: "2023-03-01", NextAnniversaryHundredYear: "44985", TotalDays: 1096, Years: 3,
+			},
+		},
+		{
+			name:           "Relative reference date",
+			payload:        `{"date": "1990-01-31", "asOf": "EOY"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputAge{
+				AnniversaryYears: 34, AsOf: "2023-12-31", DaysToAnniversary: 31, ErrorFlag: "0", LeapDay: "*FEB28",
+				Months: 11, NextAnniversary: "2024-01-31", NextAnniversaryHundredYear: "45321", TotalDays: 12387, Years: 33,
+			},
+		},
+		{
+			name:           "Hire date as a HYD",
+			payload:        `{"date": "45138", "format": "*HYD"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputAge{
+				AnniversaryYears: 1, AsOf: "2023-08-31", Days: 30, DaysToAnniversary: 336, ErrorFlag: "0", LeapDay: "*FEB28",
+				NextAnniversary: "2024-08-01", NextAnniversaryHundredYear: "45504", TotalDays: 30,
+			},
+		},
+		{
+			name:           "Unknown leap day policy",
+			payload:        `{"date": "2020-02-29", "leapDay": "*MAR2"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedValues: models.OutputAge{ErrorFlag: "HTTP 400", ErrorText: "invalid leapDay: must be *FEB28 or *MAR1"},
+		},
+		{
+			name:           "Reference date before the date",
+			payload:        `{"date": "2023-09-01"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedValues: models.OutputAge{ErrorFlag: "HTTP 400", ErrorText: "invalid asOf: must not be before the date"},
+		},
+		{
+			name:           "Unreadable reference date",
+			payload:        `{"date": "1990-01-31", "asOf": "someday"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedValues: models.OutputAge{ErrorFlag: "HTTP 400", ErrorText: "asOf: invalid date: unrecognized format: someday"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/Age", strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+
+			var responseWrapper struct {
+				Results     models.OutputAge     `json:"results"`
+				Anniversary models.OutputResults `json:"anniversary"`
+			}
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tc.expectedValues, responseWrapper.Results)
+			if tc.expectedStatus == http.StatusOK {
+				assert.Equal(t, tc.expectedValues.NextAnniversary, responseWrapper.Anniversary.InternationalStandard)
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package controller
 
 import (
+	"date_calculation/cvtdat"
 	"date_calculation/models"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -37,11 +39,38 @@ func CalcCalendarDate(context *gin.Context) {
 		return
 	}
 
+	options, err := newConversionOptions(input.ConversionOptions)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
 	if input.Date == "" {
 		handleError(http.StatusBadRequest, "invalid date: empty")
 		return
 	}
 
+	if sentinel := detectSentinel(input.Date); sentinel != "" {
+		output.Sentinel = sentinel
+		output.ErrorFlag = "0"
+		context.IndentedJSON(http.StatusOK, gin.H{"results": output})
+		return
+	}
+
+	if strings.EqualFold(input.Mode, "relative") {
+		relativeCalendarDate(context, input, options, true)
+		return
+	}
+
+	if strings.EqualFold(input.Mode, "auto") {
+		detectCalendarDate(context, input, options)
+		return
+	}
+
+	if relativeCalendarDate(context, input, options, false) {
+		return
+	}
+
 	if strings.Contains(input.Date, "-") {
 		handleError(http.StatusBadRequest, "invalid separators: use / instead")
 		return
@@ -58,13 +87,58 @@ func CalcCalendarDate(context *gin.Context) {
 		return
 	}
 
-	output = calcDatesByCalendarDate(parsedDate.Format("1/2/2006"))
+	output = calcDatesByCalendarDate(parsedDate.Format("1/2/2006"), options)
 
-	context.IndentedJSON(http.StatusOK, gin.H{"results": output})
+	context.IndentedJSON(http.StatusOK, withAdjusted(gin.H{"results": output}, parsedDate.Format("1/2/2006"), options))
 }
 
-func isHydInRange(number int) bool {
-	return number >= 0 && number <= 99999
+// Day 0 of the hundred year date
+var hundredYearReference = time.Date(1899, 12, 31, 0, 0, 0, 0, time.UTC)
+
+type hundredYearRange struct {
+	min int
+	max int
+}
+
+var hundredYearRanges = map[string]hundredYearRange{
+	"*LEGACY":   {min: minStoredHundredYear, max: maxStoredHundredYear}, // 12/31/1899 - 10/14/2173
+	"*EXTENDED": {min: -693594, max: 2958464},                           // 1/1/0001 - 12/31/9999
+}
+
+// DefaultHundredYearRange applies when a request does not pick a range
+var DefaultHundredYearRange = "*EXTENDED"
+
+func lookupHundredYearRange(name string) (hundredYearRange, bool) {
+	if name == "" {
+		name = DefaultHundredYearRange
+	}
+
+	hydRange, ok := hundredYearRanges[strings.ToUpper(name)]
+	return hydRange, ok
+}
+
+// SetDefaultHundredYearRange picks the range for requests that do not name one
+func SetDefaultHundredYearRange(name string) error {
+	if _, ok := hundredYearRanges[strings.ToUpper(name)]; !ok {
+		return errors.New("invalid 100 year date range: must be *LEGACY or *EXTENDED")
+	}
+
+	DefaultHundredYearRange = strings.ToUpper(name)
+	return nil
+}
+
+func (r hundredYearRange) contains(number int) bool {
+	return number >= r.min && number <= r.max
+}
+
+func (r hundredYearRange) isLegacy() bool {
+	return r == hundredYearRanges["*LEGACY"]
+}
+
+// Whole days are counted from Unix seconds; a time.Duration overflows
+// after 292 years
+func hundredYearDay(date time.Time) int {
+	return int((date.Unix() - hundredYearReference.Unix()) / (24 * 60 * 60))
 }
 
 func CalcHundreYearDate(context *gin.Context) {
@@ -82,19 +156,42 @@ func CalcHundreYearDate(context *gin.Context) {
 		return
 	}
 
+	options, err := newConversionOptions(input.ConversionOptions)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
 	if input.HundredYear == "" {
 		handleError(http.StatusBadRequest, "invalid 100 year date: empty")
 		return
 	}
 
+	hydRange, ok := lookupHundredYearRange(input.HydRange)
+	if !ok {
+		handleError(http.StatusBadRequest, "invalid 100 year date range: must be *LEGACY or *EXTENDED")
+		return
+	}
+
+	// 0 and 99999 mean "no date" as they do in the 5 digit host field,
+	// unless the caller asks for *EXTENDED to read them as plain days
+	explicitExtended := input.HydRange != "" && !hydRange.isLegacy()
+	if sentinel := detectSentinel(input.HundredYear); sentinel != "" && !explicitExtended {
+		output.Sentinel = sentinel
+		output.ErrorFlag = "0"
+		context.IndentedJSON(http.StatusOK, gin.H{"results": output})
+		return
+	}
+
 	hundredYear, err := strconv.Atoi(input.HundredYear)
 	if err != nil {
-		handleError(http.StatusBadRequest, "invalid 100 year date: must be a positive number")
+		handleError(http.StatusBadRequest, "invalid 100 year date: must be a whole number")
 		return
 	}
 
-	if !isHydInRange(hundredYear) {
-		handleError(http.StatusBadRequest, "100 year date out of range: must be between 0 and 99999")
+	if !hydRange.contains(hundredYear) {
+		handleError(http.StatusBadRequest, "100 year date out of range: must be between "+
+			strconv.Itoa(hydRange.min)+" and "+strconv.Itoa(hydRange.max))
 		return
 	}
 
@@ -104,20 +201,20 @@ func CalcHundreYearDate(context *gin.Context) {
 		return
 	}
 
-	output = calcDatesByCalendarDate(inputDate)
-	context.IndentedJSON(http.StatusOK, gin.H{"results": output})
+	output = calcDatesByCalendarDate(inputDate, options)
+	context.IndentedJSON(http.StatusOK, withAdjusted(gin.H{"results": output}, inputDate, options))
 }
 
 func calcCalendarDateByHundredYear(inputDate int) (string, error) {
-	referenceDate := time.Date(1899, 12, 31, 0, 0, 0, 0, time.UTC)
-
-	daysSinceReference := inputDate
-	calculatedDate := referenceDate.Add(time.Duration(daysSinceReference) * 24 * time.Hour)
+	calculatedDate := hundredYearReference.AddDate(0, 0, inputDate)
+	if calculatedDate.Year() < 1 || calculatedDate.Year() > 9999 {
+		return "", errors.New("100 year date out of range: " + strconv.Itoa(inputDate))
+	}
 
-	return calculatedDate.Format("1/02/2006"), nil
+	return calculatedDate.Format("1/2/2006"), nil
 }
 
-func calcDatesByCalendarDate(inputDate string) models.OutputResults {
+func calcDatesByCalendarDate(inputDate string, options conversionOptions) models.OutputResults {
 	var output models.OutputResults
 
 	output.AcscEuropean = calcAcscEuropean(inputDate)
@@ -125,10 +222,18 @@ func calcDatesByCalendarDate(inputDate string) models.OutputResults {
 	output.AcscInternational = calcAcscInternationalStandard(inputDate)
 	output.AcscJulian = calcAcscJulian(inputDate)
 	output.AcscUsaStandard = calcAcscUsaStandard(inputDate)
+	output.AcscWindow = options.window.String()
+	output.AcscOutsideWindow = !isInWindow(inputDate, options.window)
+	calcCalendarFacts(inputDate, &output)
 	output.DayOfWeek = calcDayOfWeek(inputDate)
 	output.EuropeanStandard = calcEuropeanStandard(inputDate)
+	output.Fiscal = calcFiscalPeriod(inputDate, options)
 	output.InternationalStandard = calcInternationalStandard(inputDate)
+	output.IsHoliday, output.HolidayName, output.IsBusinessDay = calcHoliday(inputDate, options)
+	output.IsoWeekYear, output.IsoWeek, output.IsoWeekday, output.IsoWeekDate = calcIsoWeek(inputDate)
+	output.Representable = calcRepresentable(inputDate, output.AcscHundredYear)
 	output.UsaStandard = padUsaStandard(inputDate)
+	output.UsWeekOfYear = calcUsWeekOfYear(inputDate)
 	output.ErrorFlag = "0"
 
 	return output
@@ -145,10 +250,7 @@ func calcAcscHundredYear(inputDate string) string {
 		return ""
 	}
 
-	referenceDate := time.Date(1899, 12, 31, 0, 0, 0, 0, time.UTC)
-	days := parsedDate.Sub(referenceDate).Hours() / 24
-
-	return strconv.Itoa(int(days))
+	return strconv.Itoa(hundredYearDay(parsedDate))
 }
 
 func calcEuropeanStandard(inputDate string) string {
@@ -173,6 +275,16 @@ func calcAcscJulian(inputDate string) string {
 	return fmt.Sprintf("%02d-%03d", as400JulianYear, daysInto2023)
 }
 
+func isInWindow(inputDate string, window cvtdat.Window) bool {
+	parsedDate, err := time.Parse("1/2/2006", inputDate)
+	if err != nil {
+		fmt.Println("Error:", err)
+		return false
+	}
+
+	return window.Contains(parsedDate.Year())
+}
+
 func calcAcscUsaStandard(inputDate string) string {
 	date := formatUsaStandard(inputDate, "1/2/06")
 	if len(date) == 6 {
@@ -195,6 +307,42 @@ func calcDayOfWeek(inputDate string) string {
 	return weekdayAbbreviations[dayOfWeek]
 }
 
+func calcHoliday(inputDate string, options conversionOptions) (bool, string, bool) {
+	parsedDate, err := time.Parse("1/2/2006", inputDate)
+	if err != nil {
+		fmt.Println("Error:", err)
+		return false, "", false
+	}
+
+	calendar := options.calendar
+	return calendar.IsHoliday(parsedDate), calendar.HolidayName(parsedDate), calendar.IsBusinessDay(parsedDate, options.weekend)
+}
+
+func calcFiscalPeriod(inputDate string, options conversionOptions) *models.FiscalPeriod {
+	if options.fiscal == nil {
+		return nil
+	}
+
+	parsedDate, err := time.Parse("1/2/2006", inputDate)
+	if err != nil {
+		fmt.Println("Error:", err)
+		return nil
+	}
+
+	period := options.fiscal.Locate(parsedDate)
+	return &models.FiscalPeriod{
+		Year:        period.Year,
+		Quarter:     period.Quarter,
+		Period:      period.Period,
+		Week:        period.Week,
+		WeeksInYear: period.WeeksInYear,
+		YearStart:   period.YearStart.Format("2006-01-02"),
+		YearEnd:     period.YearEnd.Format("2006-01-02"),
+		PeriodStart: period.PeriodStart.Format("2006-01-02"),
+		PeriodEnd:   period.PeriodEnd.Format("2006-01-02"),
+	}
+}
+
 func calcInternationalStandard(inputDate string) string {
 	return formatUsaStandard(inputDate, "2006-01-02")
 }
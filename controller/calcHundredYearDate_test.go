@@ -36,7 +36,7 @@ func TestCalcHundreYearDate_ErrorHandling(t *testing.T) {
 				AcscUsaStandard:       "",
 				DayOfWeek:             "",
 				ErrorFlag:             "HTTP 400",
-				ErrorText:             "invalid 100 year date: must be a positive number",
+				ErrorText:             "invalid 100 year date: must be a whole number",
 				EuropeanStandard:      "",
 				InternationalStandard: "",
 				UsaStandard:           "",
@@ -54,7 +54,7 @@ func TestCalcHundreYearDate_ErrorHandling(t *testing.T) {
 				AcscUsaStandard:       "",
 				DayOfWeek:             "",
 				ErrorFlag:             "HTTP 400",
-				ErrorText:             "invalid 100 year date: must be a positive number",
+				ErrorText:             "invalid 100 year date: must be a whole number",
 				EuropeanStandard:      "",
 				InternationalStandard: "",
 				UsaStandard:           "",
@@ -90,7 +90,7 @@ func TestCalcHundreYearDate_ErrorHandling(t *testing.T) {
 				AcscUsaStandard:       "",
 				DayOfWeek:             "",
 				ErrorFlag:             "HTTP 400",
-				ErrorText:             "invalid 100 year date: must be a positive number",
+				ErrorText:             "invalid 100 year date: must be a whole number",
 				EuropeanStandard:      "",
 				InternationalStandard: "",
 				UsaStandard:           "",
@@ -98,7 +98,7 @@ func TestCalcHundreYearDate_ErrorHandling(t *testing.T) {
 		},
 		{
 			name:           "Hundred Year Out of Range - Negative",
-			payload:        `{"date": "-1"}`,
+			payload:        `{"date": "-1", "hydRange": "*LEGACY"}`,
 			expectedStatus: http.StatusBadRequest,
 			expectedValues: models.OutputResults{
 				AcscEuropean:          "",
@@ -116,7 +116,7 @@ func TestCalcHundreYearDate_ErrorHandling(t *testing.T) {
 		},
 		{
 			name:           "Hundred Year Out of Range - High",
-			payload:        `{"date": "100000"}`,
+			payload:        `{"date": "100000", "hydRange": "*LEGACY"}`,
 			expectedStatus: http.StatusBadRequest,
 			expectedValues: models.OutputResults{
 				AcscEuropean:          "",
@@ -132,6 +132,33 @@ func TestCalcHundreYearDate_ErrorHandling(t *testing.T) {
 				UsaStandard:           "",
 			},
 		},
+		{
+			name:           "Extended Hundred Year Out of Range - Low",
+			payload:        `{"date": "-693595"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedValues: models.OutputResults{
+				ErrorFlag: "HTTP 400",
+				ErrorText: "100 year date out of range: must be between -693594 and 2958464",
+			},
+		},
+		{
+			name:           "Extended Hundred Year Out of Range - High",
+			payload:        `{"date": "2958465"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedValues: models.OutputResults{
+				ErrorFlag: "HTTP 400",
+				ErrorText: "100 year date out of range: must be between -693594 and 2958464",
+			},
+		},
+		{
+			name:           "Unknown Hundred Year Range",
+			payload:        `{"date": "12345", "hydRange": "*SHORT"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedValues: models.OutputResults{
+				ErrorFlag: "HTTP 400",
+				ErrorText: "invalid 100 year date range: must be *LEGACY or *EXTENDED",
+			},
+		},
 		{
 			name:           "Malformed JSON",
 			payload:        `{"date": ""`,
@@ -186,6 +213,78 @@ func TestCalcHundreYearDate_ErrorHandling(t *testing.T) {
 				UsaStandard:           " 9/21/2023",
 			},
 		},
+		{
+			name:           "Extended HYD Date - Negative",
+			payload:        `{"date": "-1"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputResults{
+				AcscEuropean:          "30.12.99",
+				AcscHundredYear:       "-1",
+				AcscInternational:     "99-12-30",
+				AcscJulian:            "99-364",
+				AcscUsaStandard:       "12/30/99",
+				DayOfWeek:             "SAT.",
+				ErrorFlag:             "0",
+				ErrorText:             "",
+				EuropeanStandard:      "30.12.1899",
+				InternationalStandard: "1899-12-30",
+				UsaStandard:           "12/30/1899",
+			},
+		},
+		{
+			name:           "Extended HYD Date - Beyond 5 Digits",
+			payload:        `{"date": "100000"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputResults{
+				AcscEuropean:          "15.10.73",
+				AcscHundredYear:       "100000",
+				AcscInternational:     "73-10-15",
+				AcscJulian:            "73-288",
+				AcscUsaStandard:       "10/15/73",
+				DayOfWeek:             "FRI.",
+				ErrorFlag:             "0",
+				ErrorText:             "",
+				EuropeanStandard:      "15.10.2173",
+				InternationalStandard: "2173-10-15",
+				UsaStandard:           "10/15/2173",
+			},
+		},
+		{
+			name:           "Extended HYD Date - First Day",
+			payload:        `{"date": "-693594"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputResults{
+				AcscEuropean:          "01.01.01",
+				AcscHundredYear:       "-693594",
+				AcscInternational:     "01-01-01",
+				AcscJulian:            "01-001",
+				AcscUsaStandard:       "  1/1/01",
+				DayOfWeek:             "MON.",
+				ErrorFlag:             "0",
+				ErrorText:             "",
+				EuropeanStandard:      "01.01.0001",
+				InternationalStandard: "0001-01-01",
+				UsaStandard:           "  1/1/0001",
+			},
+		},
+		{
+			name:           "Extended HYD Date - Last Day",
+			payload:        `{"date": "2958464"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputResults{
+				AcscEuropean:          "31.12.99",
+				AcscHundredYear:       "2958464",
+				AcscInternational:     "99-12-31",
+				AcscJulian:            "99-365",
+				AcscUsaStandard:       "12/31/99",
+				DayOfWeek:             "FRI.",
+				ErrorFlag:             "0",
+				ErrorText:             "",
+				EuropeanStandard:      "31.12.9999",
+				InternationalStandard: "9999-12-31",
+				UsaStandard:           "12/31/9999",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -217,3 +316,13 @@ func TestCalcHundreYearDate_ErrorHandling(t *testing.T) {
 		})
 	}
 }
+
+func TestSetDefaultHundredYearRange(t *testing.T) {
+	defer func() { DefaultHundredYearRange = "*EXTENDED" }()
+
+	assert.NoError(t, SetDefaultHundredYearRange("*legacy"))
+	assert.Equal(t, "*LEGACY", DefaultHundredYearRange)
+
+	assert.EqualError(t, SetDefaultHundredYearRange("*WIDE"), "invalid 100 year date range: must be *LEGACY or *EXTENDED")
+	assert.Equal(t, "*LEGACY", DefaultHundredYearRange)
+}
@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"date_calculation/cvtdat"
+	"date_calculation/models"
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Accepts YY-DDD, YYDDD, YYYY-DDD and YYYYDDD
+var julianPattern = regexp.MustCompile(`^(\d{2}|\d{4})-?(\d{3})$`)
+
+func CalcJulianDate(context *gin.Context) {
+	var input models.InputJulianDate
+	var output models.OutputResults
+
+	handleError := func(status int, errorString string) {
+		output.ErrorFlag = "HTTP " + strconv.Itoa(status)
+		output.ErrorText = errorString
+		context.JSON(status, gin.H{"results": output})
+	}
+
+	if err := context.ShouldBindJSON(&input); err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	options, err := newConversionOptions(input.ConversionOptions)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if input.Julian == "" {
+		handleError(http.StatusBadRequest, "invalid julian date: empty")
+		return
+	}
+
+	parsedDate, err := parseJulianDate(input.Julian, options.window)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	output = calcDatesByCalendarDate(parsedDate.Format("1/2/2006"), options)
+
+	context.IndentedJSON(http.StatusOK, withAdjusted(gin.H{"results": output}, parsedDate.Format("1/2/2006"), options))
+}
+
+func parseJulianDate(inputDate string, window cvtdat.Window) (time.Time, error) {
+	matches := julianPattern.FindStringSubmatch(strings.TrimSpace(inputDate))
+	if matches == nil {
+		return time.Time{}, errors.New("invalid julian date: " + inputDate)
+	}
+
+	year, _ := strconv.Atoi(matches[1])
+	if len(matches[1]) == 2 {
+		year = window.Expand(year)
+	}
+
+	if year == 0 {
+		return time.Time{}, errors.New("invalid julian date: year 0000")
+	}
+
+	day, _ := strconv.Atoi(matches[2])
+	if day < 1 || day > daysInYear(year) {
+		return time.Time{}, errors.New("julian day out of range: " + strconv.Itoa(year) +
+			" has " + strconv.Itoa(daysInYear(year)) + " days")
+	}
+
+	return time.Date(year, 1, day, 0, 0, 0, 0, time.UTC), nil
+}
+
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+func daysInYear(year int) int {
+	if isLeapYear(year) {
+		return 366
+	}
+
+	return 365
+}
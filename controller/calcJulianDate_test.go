@@ -0,0 +1,165 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalcJulianDate(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/CalcJulianDate", CalcJulianDate)
+
+	testCases := []struct {
+		name           string
+		payload        string
+		expectedStatus int
+		expectedValues models.OutputResults
+	}{
+		{
+			name:           "Short julian with separator",
+			payload:        `{"date": "23-243"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputResults{
+				AcscEuropean:          "31.08.23",
+				AcscHundredYear:       "45168",
+				AcscInternational:     "23-08-31",
+				AcscJulian:            "23-243",
+				AcscUsaStandard:       " 8/31/23",
+				DayOfWeek:             "THU.",
+				ErrorFlag:             "0",
+				ErrorText:             "",
+				EuropeanStandard:      "31.08.2023",
+				InternationalStandard: "2023-08-31",
+				UsaStandard:           " 8/31/2023",
+			},
+		},
+		{
+			name:           "Short julian without separator",
+			payload:        `{"date": "73105"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputResults{
+				AcscEuropean:          "15.04.73",
+				AcscHundredYear:       "26768",
+				AcscInternational:     "73-04-15",
+				AcscJulian:            "73-105",
+				AcscUsaStandard:       " 4/15/73",
+				DayOfWeek:             "SUN.",
+				ErrorFlag:             "0",
+				ErrorText:             "",
+				EuropeanStandard:      "15.04.1973",
+				InternationalStandard: "1973-04-15",
+				UsaStandard:           " 4/15/1973",
+			},
+		},
+		{
+			name:           "Long julian leap day 366",
+			payload:        `{"date": "2024-366"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputResults{
+				AcscEuropean:          "31.12.24",
+				AcscHundredYear:       "45656",
+				AcscInternational:     "24-12-31",
+				AcscJulian:            "24-366",
+				AcscUsaStandard:       "12/31/24",
+				DayOfWeek:             "TUE.",
+				ErrorFlag:             "0",
+				ErrorText:             "",
+				EuropeanStandard:      "31.12.2024",
+				InternationalStandard: "2024-12-31",
+				UsaStandard:           "12/31/2024",
+			},
+		},
+		{
+			name:           "Long julian without separator",
+			payload:        `{"date": "2023001"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputResults{
+				AcscEuropean:          "01.01.23",
+				AcscHundredYear:       "44926",
+				AcscInternational:     "23-01-01",
+				AcscJulian:            "23-001",
+				AcscUsaStandard:       "  1/1/23",
+				DayOfWeek:             "SUN.",
+				ErrorFlag:             "0",
+				ErrorText:             "",
+				EuropeanStandard:      "01.01.2023",
+				InternationalStandard: "2023-01-01",
+				UsaStandard:           "  1/1/2023",
+			},
+		},
+		{
+			name:           "Day 366 in a common year",
+			payload:        `{"date": "23-366"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedValues: models.OutputResults{
+				ErrorFlag: "HTTP 400",
+				ErrorText: "julian day out of range: 2023 has 365 days",
+			},
+		},
+		{
+			name:           "Day zero",
+			payload:        `{"date": "2023-000"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedValues: models.OutputResults{
+				ErrorFlag: "HTTP 400",
+				ErrorText: "julian day out of range: 2023 has 365 days",
+			},
+		},
+		{
+			name:           "Wrong number of digits",
+			payload:        `{"date": "231"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedValues: models.OutputResults{
+				ErrorFlag: "HTTP 400",
+				ErrorText: "invalid julian date: 231",
+			},
+		},
+		{
+			name:           "Missing date",
+			payload:        `{"date": ""}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedValues: models.OutputResults{
+				ErrorFlag: "HTTP 400",
+				ErrorText: "invalid julian date: empty",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/CalcJulianDate", strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+
+			var responseWrapper ResponseWrapper
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+
+			assert.NoError(t, err)
+			assert.NotEmpty(t, responseWrapper.Results.ErrorFlag)
+
+			assert.Equal(t, tc.expectedValues.AcscEuropean, responseWrapper.Results.AcscEuropean)
+			assert.Equal(t, tc.expectedValues.AcscHundredYear, responseWrapper.Results.AcscHundredYear)
+			assert.Equal(t, tc.expectedValues.AcscInternational, responseWrapper.Results.AcscInternational)
+			assert.Equal(t, tc.expectedValues.AcscJulian, responseWrapper.Results.AcscJulian)
+			assert.Equal(t, tc.expectedValues.AcscUsaStandard, responseWrapper.Results.AcscUsaStandard)
+			assert.Equal(t, tc.expectedValues.DayOfWeek, responseWrapper.Results.DayOfWeek)
+			assert.Equal(t, tc.expectedValues.ErrorFlag, responseWrapper.Results.ErrorFlag)
+			assert.Equal(t, tc.expectedValues.ErrorText, responseWrapper.Results.ErrorText)
+			assert.Equal(t, tc.expectedValues.EuropeanStandard, responseWrapper.Results.EuropeanStandard)
+			assert.Equal(t, tc.expectedValues.InternationalStandard, responseWrapper.Results.InternationalStandard)
+			assert.Equal(t, tc.expectedValues.UsaStandard, responseWrapper.Results.UsaStandard)
+		})
+	}
+}
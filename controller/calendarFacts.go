@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Fills in where a date sits in its month, quarter and year
+func calcCalendarFacts(inputDate string, output *models.OutputResults) {
+	parsedDate, err := time.Parse("1/2/2006", inputDate)
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+
+	year, month := parsedDate.Year(), parsedDate.Month()
+	quarterStartMonth := month - (month-1)%3
+	monthStart := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	quarterStart := time.Date(year, quarterStartMonth, 1, 0, 0, 0, 0, time.UTC)
+	quarterEnd := time.Date(year, quarterStartMonth+2, daysInMonth(year, quarterStartMonth+2), 0, 0, 0, 0, time.UTC)
+
+	output.DayName = parsedDate.Weekday().String()
+	output.DayOfYear = parsedDate.YearDay()
+	output.DaysInMonth = daysInMonth(year, month)
+	output.Db2DayOfWeek = int(parsedDate.Weekday()) + 1
+	output.LeapYear = isLeapYear(year)
+	output.MonthStartHundredYear = strconv.Itoa(hundredYearDay(monthStart))
+	output.MonthEndHundredYear = strconv.Itoa(hundredYearDay(monthStart) + output.DaysInMonth - 1)
+	output.Quarter = int(month-1)/3 + 1
+	output.QuarterStartHundredYear = strconv.Itoa(hundredYearDay(quarterStart))
+	output.QuarterEndHundredYear = strconv.Itoa(hundredYearDay(quarterEnd))
+	output.WeekOfMonth = (parsedDate.Day()-1+int(monthStart.Weekday()))/7 + 1
+}
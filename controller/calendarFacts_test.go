@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendarFacts(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/CalcCalendarDate", CalcCalendarDate)
+
+	testCases := []struct {
+		date           string
+		expectedValues models.OutputResults
+	}{
+		{
+			date: "8/31/2023",
+			expectedValues: models.OutputResults{
+				DayName: "Thursday", DayOfYear: 243, DaysInMonth: 31, Db2DayOfWeek: 5, IsoWeekday: 4,
+				LeapYear: false, Quarter: 3, WeekOfMonth: 5,
+				MonthStartHundredYear: "45138", MonthEndHundredYear: "45168",
+				QuarterStartHundredYear: "45107", QuarterEndHundredYear: "45198",
+			},
+		},
+		{
+			date: "2/29/2024",
+			expectedValues: models.OutputResults{
+				DayName: "Thursday", DayOfYear: 60, DaysInMonth: 29, Db2DayOfWeek: 5, IsoWeekday: 4,
+				LeapYear: true, Quarter: 1, WeekOfMonth: 5,
+				MonthStartHundredYear: "45322", MonthEndHundredYear: "45350",
+				QuarterStartHundredYear: "45291", QuarterEndHundredYear: "45381",
+			},
+		},
+		{
+			date: "1/1/2023",
+			expectedValues: models.OutputResults{
+				DayName: "Sunday", DayOfYear: 1, DaysInMonth: 31, Db2DayOfWeek: 1, IsoWeekday: 7,
+				LeapYear: false, Quarter: 1, WeekOfMonth: 1,
+				MonthStartHundredYear: "44926", MonthEndHundredYear: "44956",
+				QuarterStartHundredYear: "44926", QuarterEndHundredYear: "45015",
+			},
+		},
+		{
+			date: "12/30/9999",
+			expectedValues: models.OutputResults{
+				DayName: "Thursday", DayOfYear: 364, DaysInMonth: 31, Db2DayOfWeek: 5, IsoWeekday: 4,
+				LeapYear: false, Quarter: 4, WeekOfMonth: 5,
+				MonthStartHundredYear: "2958434", MonthEndHundredYear: "2958464",
+				QuarterStartHundredYear: "2958373", QuarterEndHundredYear: "2958464",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.date, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/CalcCalendarDate", strings.NewReader(`{"date": "`+tc.date+`"}`))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var responseWrapper ResponseWrapper
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+			assert.NoError(t, err)
+
+			results := responseWrapper.Results
+			assert.Equal(t, tc.expectedValues.DayName, results.DayName)
+			assert.Equal(t, tc.expectedValues.DayOfYear, results.DayOfYear)
+			assert.Equal(t, tc.expectedValues.DaysInMonth, results.DaysInMonth)
+			assert.Equal(t, tc.expectedValues.Db2DayOfWeek, results.Db2DayOfWeek)
+			assert.Equal(t, tc.expectedValues.IsoWeekday, results.IsoWeekday)
+			assert.Equal(t, tc.expectedValues.LeapYear, results.LeapYear)
+			assert.Equal(t, tc.expectedValues.Quarter, results.Quarter)
+			assert.Equal(t, tc.expectedValues.WeekOfMonth, results.WeekOfMonth)
+			assert.Equal(t, tc.expectedValues.MonthStartHundredYear, results.MonthStartHundredYear)
+			assert.Equal(t, tc.expectedValues.MonthEndHundredYear, results.MonthEndHundredYear)
+			assert.Equal(t, tc.expectedValues.QuarterStartHundredYear, results.QuarterStartHundredYear)
+			assert.Equal(t, tc.expectedValues.QuarterEndHundredYear, results.QuarterEndHundredYear)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package controller
+
+import (
+	"date_calculation/clock"
+	"date_calculation/cvtdat"
+	"date_calculation/fiscal"
+	"date_calculation/holiday"
+	"date_calculation/models"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const defaultSlidingYears = 50
+
+// Settings shared by every endpoint that converts a date
+type conversionOptions struct {
+	window   cvtdat.Window
+	calendar *holiday.Calendar
+	weekend  holiday.Weekend
+	roll     string
+	fiscal   *fiscal.Calendar
+}
+
+var defaultOptions = conversionOptions{
+	window:   cvtdat.IBMWindow,
+	calendar: holiday.US,
+	weekend:  holiday.SaturdaySunday,
+	roll:     holiday.Unadjusted,
+}
+
+func newConversionOptions(input models.ConversionOptions) (conversionOptions, error) {
+	options := defaultOptions
+
+	if input.Calendar != "" {
+		calendar, ok := holiday.Lookup(input.Calendar)
+		if !ok {
+			return options, errors.New("invalid calendar: must be one of " + strings.Join(holiday.Names(), ", "))
+		}
+		options.calendar = calendar
+	}
+
+	weekend, err := holiday.ParseWeekend(input.Weekend)
+	if err != nil {
+		return options, err
+	}
+	options.weekend = weekend
+
+	roll, err := holiday.ParseConvention(input.Roll)
+	if err != nil {
+		return options, err
+	}
+	options.roll = roll
+
+	if input.Fiscal != nil {
+		calendar, err := newFiscalCalendar(*input.Fiscal)
+		if err != nil {
+			return options, err
+		}
+		options.fiscal = &calendar
+	}
+
+	window := input.WindowOptions
+	switch strings.ToUpper(window.WindowPolicy) {
+	case "", "*IBMI":
+	case "*FIXED":
+		if window.WindowStart < 1 || window.WindowStart > 9900 {
+			return options, errors.New("invalid window start: must be a year between 1 and 9900")
+		}
+		options.window = cvtdat.FixedWindow(window.WindowStart)
+	case "*SLIDING":
+		years := window.WindowYears
+		if years == 0 {
+			years = defaultSlidingYears
+		}
+		if years < 0 || years > 99 {
+			return options, errors.New("invalid window years: must be between 1 and 99")
+		}
+		options.window = cvtdat.SlidingWindow(clock.Now(), years)
+	default:
+		return options, errors.New("invalid window policy: must be *IBMI, *FIXED or *SLIDING")
+	}
+
+	return options, nil
+}
+
+func newFiscalCalendar(input models.FiscalOptions) (fiscal.Calendar, error) {
+	if input.Preset != "" {
+		if !strings.EqualFold(input.Preset, "*NRF") {
+			return fiscal.Calendar{}, errors.New("invalid fiscal preset: must be *NRF")
+		}
+		return fiscal.NRF, nil
+	}
+
+	calendar := fiscal.Calendar{
+		StartMonth: time.Month(input.StartMonth),
+		Pattern:    strings.TrimSpace(input.Pattern),
+		EndRule:    strings.ToUpper(input.EndRule),
+		YearLabel:  strings.ToUpper(input.YearLabel),
+	}
+	if calendar.StartMonth == 0 {
+		calendar.StartMonth = time.January
+	}
+	if calendar.EndRule == "" {
+		calendar.EndRule = fiscal.Last
+	}
+	if calendar.YearLabel == "" {
+		calendar.YearLabel = fiscal.LabelEnd
+	}
+
+	if calendar.Pattern != "" {
+		weekday, ok := holiday.ParseWeekday(input.EndWeekday)
+		if !ok {
+			return calendar, errors.New("invalid fiscal end weekday: " + input.EndWeekday)
+		}
+		calendar.EndWeekday = weekday
+	}
+
+	return calendar, calendar.Validate()
+}
+
+// Adds the date rolled to a business day when the request asked for a roll
+func withAdjusted(response gin.H, inputDate string, options conversionOptions) gin.H {
+	if options.roll == holiday.Unadjusted {
+		return response
+	}
+
+	parsedDate, err := time.Parse("1/2/2006", inputDate)
+	if err != nil {
+		fmt.Println("Error:", err)
+		return response
+	}
+
+	adjustedDate := options.calendar.Roll(parsedDate, options.roll, options.weekend)
+	response["adjusted"] = calcDatesByCalendarDate(adjustedDate.Format("1/2/2006"), options)
+
+	return response
+}
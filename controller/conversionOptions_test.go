@@ -0,0 +1,376 @@
+package controller
+
+import (
+	"date_calculation/clock"
+	"date_calculation/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWindowOptions(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/CalcCalendarDate", CalcCalendarDate)
+	router.POST("/api/CalcJulianDate", CalcJulianDate)
+
+	thisYear := clock.Now().Year()
+	slidingWindow := strconv.Itoa(thisYear-50) + "-" + strconv.Itoa(thisYear+49)
+
+	testCases := []struct {
+		name                  string
+		url                   string
+		payload               string
+		expectedStatus        int
+		expectedWindow        string
+		expectedOutside       bool
+		expectedInternational string
+		expectedErrorText     string
+	}{
+		{
+			name:                  "Default IBM i window",
+			url:                   "/api/CalcCalendarDate",
+			payload:               `{"date": "8/31/2023"}`,
+			expectedStatus:        http.StatusOK,
+			expectedWindow:        "1940-2039",
+			expectedInternational: "2023-08-31",
+		},
+		{
+			name:                  "Outside IBM i window",
+			url:                   "/api/CalcCalendarDate",
+			payload:               `{"date": "10/14/2173"}`,
+			expectedStatus:        http.StatusOK,
+			expectedWindow:        "1940-2039",
+			expectedOutside:       true,
+			expectedInternational: "2173-10-14",
+		},
+		{
+			name:                  "Fixed window covers the date",
+			url:                   "/api/CalcCalendarDate",
+			payload:               `{"date": "10/14/2173", "windowPolicy": "*FIXED", "windowStart": 2100}`,
+			expectedStatus:        http.StatusOK,
+			expectedWindow:        "2100-2199",
+			expectedInternational: "2173-10-14",
+		},
+		{
+			name:                  "Fixed window applied to short julian input",
+			url:                   "/api/CalcJulianDate",
+			payload:               `{"date": "45001", "windowPolicy": "*FIXED", "windowStart": 1950}`,
+			expectedStatus:        http.StatusOK,
+			expectedWindow:        "1950-2049",
+			expectedInternational: "2045-01-01",
+		},
+		{
+			name:                  "Fixed window applied to auto detected input",
+			url:                   "/api/CalcCalendarDate",
+			payload:               `{"date": "450101", "mode": "auto", "order": "YMD", "windowPolicy": "*FIXED", "windowStart": 1950}`,
+			expectedStatus:        http.StatusOK,
+			expectedWindow:        "1950-2049",
+			expectedInternational: "2045-01-01",
+		},
+		{
+			name:                  "Sliding window",
+			url:                   "/api/CalcCalendarDate",
+			payload:               `{"date": "8/31/2023", "windowPolicy": "*SLIDING"}`,
+			expectedStatus:        http.StatusOK,
+			expectedWindow:        slidingWindow,
+			expectedInternational: "2023-08-31",
+		},
+		{
+			name:              "Unknown policy",
+			url:               "/api/CalcCalendarDate",
+			payload:           `{"date": "8/31/2023", "windowPolicy": "*ROLLING"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid window policy: must be *IBMI, *FIXED or *SLIDING",
+		},
+		{
+			name:              "Sliding window too wide",
+			url:               "/api/CalcJulianDate",
+			payload:           `{"date": "23-243", "windowPolicy": "*SLIDING", "windowYears": 100}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid window years: must be between 1 and 99",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", tc.url, strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+
+			var responseWrapper ResponseWrapper
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedWindow, responseWrapper.Results.AcscWindow)
+			assert.Equal(t, tc.expectedOutside, responseWrapper.Results.AcscOutsideWindow)
+			assert.Equal(t, tc.expectedInternational, responseWrapper.Results.InternationalStandard)
+			assert.Equal(t, tc.expectedErrorText, responseWrapper.Results.ErrorText)
+		})
+	}
+}
+
+func TestCalendarOptions(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/CalcCalendarDate", CalcCalendarDate)
+	router.POST("/api/CalcHundredYearDate", CalcHundreYearDate)
+
+	testCases := []struct {
+		name                  string
+		url                   string
+		payload               string
+		expectedStatus        int
+		expectedIsHoliday     bool
+		expectedHolidayName   string
+		expectedIsBusinessDay bool
+		expectedErrorText     string
+	}{
+		{
+			name:                  "Default US calendar",
+			url:                   "/api/CalcCalendarDate",
+			payload:               `{"date": "7/4/2023"}`,
+			expectedStatus:        http.StatusOK,
+			expectedIsHoliday:     true,
+			expectedHolidayName:   "Independence Day",
+			expectedIsBusinessDay: false,
+		},
+		{
+			name:                  "Observed Friday",
+			url:                   "/api/CalcCalendarDate",
+			payload:               `{"date": "7/3/2020"}`,
+			expectedStatus:        http.StatusOK,
+			expectedIsHoliday:     true,
+			expectedHolidayName:   "Independence Day (observed)",
+			expectedIsBusinessDay: false,
+		},
+		{
+			name:                  "Ordinary weekday",
+			url:                   "/api/CalcHundredYearDate",
+			payload:               `{"date": "45189"}`,
+			expectedStatus:        http.StatusOK,
+			expectedIsBusinessDay: true,
+		},
+		{
+			name:                  "Weekend",
+			url:                   "/api/CalcCalendarDate",
+			payload:               `{"date": "9/23/2023"}`,
+			expectedStatus:        http.StatusOK,
+			expectedIsBusinessDay: false,
+		},
+		{
+			name:                  "UK calendar",
+			url:                   "/api/CalcCalendarDate",
+			payload:               `{"date": "4/1/2024", "calendar": "UK"}`,
+			expectedStatus:        http.StatusOK,
+			expectedIsHoliday:     true,
+			expectedHolidayName:   "Easter Monday",
+			expectedIsBusinessDay: false,
+		},
+		{
+			name:              "Unknown calendar",
+			url:               "/api/CalcCalendarDate",
+			payload:           `{"date": "4/1/2024", "calendar": "XX"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid calendar: must be one of NONE, UK, US",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", tc.url, strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+
+			var responseWrapper ResponseWrapper
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedIsHoliday, responseWrapper.Results.IsHoliday)
+			assert.Equal(t, tc.expectedHolidayName, responseWrapper.Results.HolidayName)
+			assert.Equal(t, tc.expectedIsBusinessDay, responseWrapper.Results.IsBusinessDay)
+			assert.Equal(t, tc.expectedErrorText, responseWrapper.Results.ErrorText)
+		})
+	}
+}
+
+func TestRollOptions(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/CalcCalendarDate", CalcCalendarDate)
+	router.POST("/api/CalcHundredYearDate", CalcHundreYearDate)
+	router.POST("/api/AddDuration", AddDuration)
+
+	testCases := []struct {
+		name              string
+		url               string
+		payload           string
+		expectedStatus    int
+		expectedRaw       string
+		expectedAdjusted  string
+		expectedErrorText string
+	}{
+		{
+			name:             "Following over Christmas",
+			url:              "/api/CalcCalendarDate",
+			payload:          `{"date": "12/23/2023", "roll": "*FOLLOWING"}`,
+			expectedStatus:   http.StatusOK,
+			expectedRaw:      "2023-12-23",
+			expectedAdjusted: "2023-12-26",
+		},
+		{
+			name:             "Modified following at month end",
+			url:              "/api/CalcHundredYearDate",
+			payload:          `{"date": "45198", "roll": "*MODFOLLOWING"}`,
+			expectedStatus:   http.StatusOK,
+			expectedRaw:      "2023-09-30",
+			expectedAdjusted: "2023-09-29",
+		},
+		{
+			name:             "Arithmetic result rolled back",
+			url:              "/api/AddDuration",
+			payload:          `{"date": "2023-08-31", "months": 1, "roll": "*PRECEDING", "calendar": "US"}`,
+			expectedStatus:   http.StatusOK,
+			expectedRaw:      "2023-09-30",
+			expectedAdjusted: "2023-09-29",
+		},
+		{
+			name:           "No roll requested",
+			url:            "/api/CalcCalendarDate",
+			payload:        `{"date": "12/23/2023"}`,
+			expectedStatus: http.StatusOK,
+			expectedRaw:    "2023-12-23",
+		},
+		{
+			name:              "Unknown roll",
+			url:               "/api/CalcCalendarDate",
+			payload:           `{"date": "12/23/2023", "roll": "*NEAREST"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid roll: must be *UNADJUSTED, *FOLLOWING, *MODFOLLOWING, *PRECEDING or *MODPRECEDING",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", tc.url, strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+
+			var responseWrapper struct {
+				Results  models.OutputResults `json:"results"`
+				Adjusted models.OutputResults `json:"adjusted"`
+			}
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedRaw, responseWrapper.Results.InternationalStandard)
+			assert.Equal(t, tc.expectedAdjusted, responseWrapper.Adjusted.InternationalStandard)
+			assert.Equal(t, tc.expectedErrorText, responseWrapper.Results.ErrorText)
+		})
+	}
+}
+
+func TestFiscalOptions(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/CalcCalendarDate", CalcCalendarDate)
+
+	testCases := []struct {
+		name              string
+		payload           string
+		expectedStatus    int
+		expectedFiscal    *models.FiscalPeriod
+		expectedErrorText string
+	}{
+		{
+			name:           "No fiscal calendar",
+			payload:        `{"date": "8/31/2023"}`,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "NRF preset",
+			payload:        `{"date": "8/31/2023", "fiscal": {"preset": "*NRF"}}`,
+			expectedStatus: http.StatusOK,
+			expectedFiscal: &models.FiscalPeriod{
+				Year: 2023, Quarter: 3, Period: 8, Week: 31, WeeksInYear: 53,
+				YearStart: "2023-01-29", YearEnd: "2024-02-03",
+				PeriodStart: "2023-08-27", PeriodEnd: "2023-09-30",
+			},
+		},
+		{
+			name:           "Year starting in October",
+			payload:        `{"date": "8/31/2023", "fiscal": {"startMonth": 10}}`,
+			expectedStatus: http.StatusOK,
+			expectedFiscal: &models.FiscalPeriod{
+				Year: 2023, Quarter: 4, Period: 11, Week: 48, WeeksInYear: 53,
+				YearStart: "2022-10-01", YearEnd: "2023-09-30",
+				PeriodStart: "2023-08-01", PeriodEnd: "2023-08-31",
+			},
+		},
+		{
+			name:           "4-4-5 ending on the last Saturday",
+			payload:        `{"date": "3/31/2023", "fiscal": {"pattern": "445", "endWeekday": "SAT"}}`,
+			expectedStatus: http.StatusOK,
+			expectedFiscal: &models.FiscalPeriod{
+				Year: 2023, Quarter: 1, Period: 3, Week: 13, WeeksInYear: 52,
+				YearStart: "2023-01-01", YearEnd: "2023-12-30",
+				PeriodStart: "2023-02-26", PeriodEnd: "2023-04-01",
+			},
+		},
+		{
+			name:              "Missing end weekday",
+			payload:           `{"date": "3/31/2023", "fiscal": {"pattern": "445"}}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid fiscal end weekday: ",
+		},
+		{
+			name:              "Unknown preset",
+			payload:           `{"date": "3/31/2023", "fiscal": {"preset": "*GOV"}}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid fiscal preset: must be *NRF",
+		},
+		{
+			name:              "Unknown pattern",
+			payload:           `{"date": "3/31/2023", "fiscal": {"pattern": "444", "endWeekday": "SAT"}}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid fiscal pattern: must be 445, 454 or 544",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/CalcCalendarDate", strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+
+			var responseWrapper ResponseWrapper
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedFiscal, responseWrapper.Results.Fiscal)
+			assert.Equal(t, tc.expectedErrorText, responseWrapper.Results.ErrorText)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"date_calculation/cvtdat"
+	"date_calculation/models"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+func ConvertDate(context *gin.Context) {
+	var input models.InputConvertDate
+	var output models.OutputResults
+
+	handleError := func(status int, errorString string) {
+		output.ErrorFlag = "HTTP " + strconv.Itoa(status)
+		output.ErrorText = errorString
+		context.JSON(status, gin.H{"results": output})
+	}
+
+	// CVTDAT failures report the escape message ID in place of the HTTP status
+	handleCpfError := func(err error) {
+		var cpfError *cvtdat.Error
+		if !errors.As(err, &cpfError) {
+			handleError(http.StatusBadRequest, err.Error())
+			return
+		}
+
+		output.ErrorFlag = cpfError.ID
+		output.ErrorText = cpfError.Text
+		context.JSON(http.StatusBadRequest, gin.H{"results": output})
+	}
+
+	if err := context.ShouldBindJSON(&input); err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	options, err := newConversionOptions(input.ConversionOptions)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if input.Date == "" {
+		handleError(http.StatusBadRequest, "invalid date: empty")
+		return
+	}
+
+	parsedDate, err := cvtdat.ParseInWindow(input.Date, input.FromFmt, options.window)
+	if err != nil {
+		handleCpfError(err)
+		return
+	}
+
+	converted, err := cvtdat.FormatInWindow(parsedDate, input.ToFmt, input.ToSep, options.window)
+	if err != nil {
+		handleCpfError(err)
+		return
+	}
+
+	output = calcDatesByCalendarDate(parsedDate.Format("1/2/2006"), options)
+
+	context.IndentedJSON(http.StatusOK, withAdjusted(gin.H{"results": output, "converted": converted},
+		parsedDate.Format("1/2/2006"), options))
+}
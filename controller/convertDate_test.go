@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertDate(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/ConvertDate", ConvertDate)
+
+	testCases := []struct {
+		name              string
+		payload           string
+		expectedStatus    int
+		expectedConverted string
+		expectedValues    models.OutputResults
+	}{
+		{
+			name:              "MDY to CYMD",
+			payload:           `{"date": "083123", "fromFmt": "*MDY", "toFmt": "*CYMD", "toSep": "*NONE"}`,
+			expectedStatus:    http.StatusOK,
+			expectedConverted: "1230831",
+			expectedValues: models.OutputResults{
+				AcscHundredYear:       "45168",
+				AcscJulian:            "23-243",
+				ErrorFlag:             "0",
+				InternationalStandard: "2023-08-31",
+				UsaStandard:           " 8/31/2023",
+			},
+		},
+		{
+			name:              "LONGJUL to USA",
+			payload:           `{"date": "1973105", "fromFmt": "*LONGJUL", "toFmt": "*USA"}`,
+			expectedStatus:    http.StatusOK,
+			expectedConverted: "04/15/1973",
+			expectedValues: models.OutputResults{
+				AcscHundredYear:       "26768",
+				AcscJulian:            "73-105",
+				ErrorFlag:             "0",
+				InternationalStandard: "1973-04-15",
+				UsaStandard:           " 4/15/1973",
+			},
+		},
+		{
+			name:           "Out of range for two digit year",
+			payload:        `{"date": "2173-10-14", "fromFmt": "*ISO", "toFmt": "*MDY"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedValues: models.OutputResults{
+				ErrorFlag: "CPF0557",
+				ErrorText: "Date outside the range allowed for the specified format.",
+			},
+		},
+		{
+			name:           "Invalid date",
+			payload:        `{"date": "02/30/23", "fromFmt": "*MDY", "toFmt": "*ISO"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedValues: models.OutputResults{
+				ErrorFlag: "CPF0555",
+				ErrorText: "Date not in specified format or date not valid.",
+			},
+		},
+		{
+			name:           "Missing date",
+			payload:        `{"date": "", "fromFmt": "*MDY", "toFmt": "*ISO"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedValues: models.OutputResults{
+				ErrorFlag: "HTTP 400",
+				ErrorText: "invalid date: empty",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/ConvertDate", strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+
+			var responseWrapper struct {
+				Results   models.OutputResults `json:"results"`
+				Converted string               `json:"converted"`
+			}
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedConverted, responseWrapper.Converted)
+			assert.Equal(t, tc.expectedValues.AcscHundredYear, responseWrapper.Results.AcscHundredYear)
+			assert.Equal(t, tc.expectedValues.AcscJulian, responseWrapper.Results.AcscJulian)
+			assert.Equal(t, tc.expectedValues.ErrorFlag, responseWrapper.Results.ErrorFlag)
+			assert.Equal(t, tc.expectedValues.ErrorText, responseWrapper.Results.ErrorText)
+			assert.Equal(t, tc.expectedValues.InternationalStandard, responseWrapper.Results.InternationalStandard)
+			assert.Equal(t, tc.expectedValues.UsaStandard, responseWrapper.Results.UsaStandard)
+		})
+	}
+}
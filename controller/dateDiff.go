@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func DateDiff(context *gin.Context) {
+	var input models.InputDateDiff
+	var output models.OutputDateDiff
+
+	handleError := func(status int, errorString string) {
+		output.ErrorFlag = "HTTP " + strconv.Itoa(status)
+		output.ErrorText = errorString
+		context.JSON(status, gin.H{"results": output})
+	}
+
+	if err := context.ShouldBindJSON(&input); err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	options, err := newConversionOptions(input.ConversionOptions)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	fromDate, err := resolveDate(input.From, input.DateOptions, options)
+	if err != nil {
+		handleError(http.StatusBadRequest, "from: "+err.Error())
+		return
+	}
+
+	toDate, err := resolveDate(input.To, input.DateOptions, options)
+	if err != nil {
+		handleError(http.StatusBadRequest, "to: "+err.Error())
+		return
+	}
+
+	output = calcDateDiff(fromDate, toDate, input.BusinessDays, options)
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"results": output,
+		"from":    calcDatesByCalendarDate(fromDate.Format("1/2/2006"), options),
+		"to":      calcDatesByCalendarDate(toDate.Format("1/2/2006"), options),
+	})
+}
+
+// Differences are signed: negative when to is before from
+func calcDateDiff(fromDate time.Time, toDate time.Time, businessDays bool, options conversionOptions) models.OutputDateDiff {
+	var output models.OutputDateDiff
+
+	sign := 1
+	start, end := fromDate, toDate
+	if toDate.Before(fromDate) {
+		sign = -1
+		start, end = toDate, fromDate
+	}
+
+	days := hundredYearDay(end) - hundredYearDay(start)
+	years, months, remainder := calcCalendarDifference(start, end)
+
+	output.Days = sign * days
+	output.InclusiveDays = sign * (days + 1)
+	output.Weeks = sign * (days / 7)
+	output.WeeksRemainderDays = sign * (days % 7)
+	output.CalendarYears = sign * years
+	output.CalendarMonths = sign * months
+	output.CalendarDays = sign * remainder
+	output.WeekdayCounts = countWeekdays(start, days+1)
+	output.ErrorFlag = "0"
+
+	if businessDays {
+		count := sign * countBusinessDays(start, end, output.WeekdayCounts, options)
+		output.BusinessDays = &count
+	}
+
+	return output
+}
+
+// Months are counted the way AddDuration adds them, so adding the result
+// to the start date gives the end date back
+func calcCalendarDifference(start time.Time, end time.Time) (int, int, int) {
+	totalMonths := (end.Year()-start.Year())*12 + int(end.Month()-start.Month())
+
+	anchor, _ := addMonths(start, totalMonths, "*CLAMP")
+	if anchor.After(end) {
+		totalMonths--
+		anchor, _ = addMonths(start, totalMonths, "*CLAMP")
+	}
+
+	return totalMonths / 12, totalMonths % 12, hundredYearDay(end) - hundredYearDay(anchor)
+}
+
+// Days outside the weekend, less the holidays' days off among them. Only
+// the days off are checked one by one, so spans of centuries stay quick.
+func countBusinessDays(start time.Time, end time.Time, weekdayCounts map[string]int, options conversionOptions) int {
+	count := 0
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if !options.weekend[weekday] {
+			count += weekdayCounts[weekday.String()]
+		}
+	}
+
+	daysOff := map[time.Time]bool{}
+	for year := start.Year(); year <= end.Year(); year++ {
+		for _, h := range options.calendar.Holidays(year) {
+			if h.Date.Before(start) || h.Date.After(end) || options.weekend[h.Date.Weekday()] {
+				continue
+			}
+			if !daysOff[h.Date] && !options.calendar.IsBusinessDay(h.Date, options.weekend) {
+				daysOff[h.Date] = true
+				count--
+			}
+		}
+	}
+
+	return count
+}
+
+func countWeekdays(start time.Time, days int) map[string]int {
+	counts := map[string]int{}
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		counts[weekday.String()] = days / 7
+	}
+
+	for i := 0; i < days%7; i++ {
+		counts[((start.Weekday()+time.Weekday(i))%7).String()]++
+	}
+
+	return counts
+}
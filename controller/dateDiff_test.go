@@ -0,0 +1,157 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateDiff(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/DateDiff", DateDiff)
+
+	businessDays := func(count int) *int { return &count }
+
+	testCases := []struct {
+		name           string
+		payload        string
+		expectedStatus int
+		expectedValues models.OutputDateDiff
+		expectedMonday int
+	}{
+		{
+			name:           "Forward across a leap day",
+			payload:        `{"from": "2024-01-31", "to": "2024-03-15", "businessDays": true}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputDateDiff{
+				BusinessDays:       businessDays(32),
+				CalendarDays:       15,
+				CalendarMonths:     1,
+				Days:               44,
+				ErrorFlag:          "0",
+				InclusiveDays:      45,
+				Weeks:              6,
+				WeeksRemainderDays: 2,
+			},
+			expectedMonday: 6,
+		},
+		{
+			name:           "Backward",
+			payload:        `{"from": "2024-03-15", "to": "2024-01-31"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputDateDiff{
+				CalendarDays:       -15,
+				CalendarMonths:     -1,
+				Days:               -44,
+				ErrorFlag:          "0",
+				InclusiveDays:      -45,
+				Weeks:              -6,
+				WeeksRemainderDays: -2,
+			},
+			expectedMonday: 6,
+		},
+		{
+			name:           "HYD inputs over several years",
+			payload:        `{"from": "26768", "to": "45189", "format": "*HYD"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputDateDiff{
+				CalendarDays:       6,
+				CalendarMonths:     5,
+				CalendarYears:      50,
+				Days:               18421,
+				ErrorFlag:          "0",
+				InclusiveDays:      18422,
+				Weeks:              2631,
+				WeeksRemainderDays: 4,
+			},
+			expectedMonday: 2632,
+		},
+		{
+			name:           "Same day",
+			payload:        `{"from": "20230831", "to": "2023-08-31", "businessDays": true}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputDateDiff{
+				BusinessDays:  businessDays(1),
+				ErrorFlag:     "0",
+				InclusiveDays: 1,
+			},
+		},
+		{
+			name:           "Holiday is not a business day",
+			payload:        `{"from": "2023-07-01", "to": "2023-07-10", "businessDays": true}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputDateDiff{
+				BusinessDays:       businessDays(5),
+				CalendarDays:       9,
+				Days:               9,
+				ErrorFlag:          "0",
+				InclusiveDays:      10,
+				Weeks:              1,
+				WeeksRemainderDays: 2,
+			},
+			expectedMonday: 2,
+		},
+		{
+			name:           "Friday and Saturday weekend backward",
+			payload:        `{"from": "2023-07-10", "to": "2023-07-01", "businessDays": true, "calendar": "NONE", "weekend": "FRI,SAT"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputDateDiff{
+				BusinessDays:       businessDays(-7),
+				CalendarDays:       -9,
+				Days:               -9,
+				ErrorFlag:          "0",
+				InclusiveDays:      -10,
+				Weeks:              -1,
+				WeeksRemainderDays: -2,
+			},
+			expectedMonday: 2,
+		},
+		{
+			name:           "Invalid to date",
+			payload:        `{"from": "2023-08-31", "to": "2023-02-30"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedValues: models.OutputDateDiff{
+				ErrorFlag: "HTTP 400",
+				ErrorText: "to: invalid date: unrecognized format: 2023-02-30",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/DateDiff", strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+
+			var responseWrapper struct {
+				Results models.OutputDateDiff `json:"results"`
+			}
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+			assert.NoError(t, err)
+
+			results := responseWrapper.Results
+			assert.Equal(t, tc.expectedValues.BusinessDays, results.BusinessDays)
+			assert.Equal(t, tc.expectedValues.CalendarDays, results.CalendarDays)
+			assert.Equal(t, tc.expectedValues.CalendarMonths, results.CalendarMonths)
+			assert.Equal(t, tc.expectedValues.CalendarYears, results.CalendarYears)
+			assert.Equal(t, tc.expectedValues.Days, results.Days)
+			assert.Equal(t, tc.expectedValues.ErrorFlag, results.ErrorFlag)
+			assert.Equal(t, tc.expectedValues.ErrorText, results.ErrorText)
+			assert.Equal(t, tc.expectedValues.InclusiveDays, results.InclusiveDays)
+			assert.Equal(t, tc.expectedValues.Weeks, results.Weeks)
+			assert.Equal(t, tc.expectedValues.WeeksRemainderDays, results.WeeksRemainderDays)
+			assert.Equal(t, tc.expectedMonday, results.WeekdayCounts["Monday"])
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package controller
+
+import (
+	"date_calculation/holiday"
+	"date_calculation/models"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Rows written between flushes; the response is never held in memory
+const rangeFlushRows = 100
+
+var rangeSteps = map[string]bool{"*DAYS": true, "*WEEKS": true, "*MONTHS": true, "*BUSINESSDAYS": true}
+
+func DateRange(context *gin.Context) {
+	var input models.InputDateRange
+	var output models.OutputResults
+
+	handleError := func(status int, errorString string) {
+		output.ErrorFlag = "HTTP " + strconv.Itoa(status)
+		output.ErrorText = errorString
+		context.JSON(status, gin.H{"results": output})
+	}
+
+	if err := context.ShouldBindJSON(&input); err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	options, err := newConversionOptions(input.ConversionOptions)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	step := strings.ToUpper(strings.TrimSpace(input.Step))
+	if step == "" {
+		step = "*DAYS"
+	}
+	if !rangeSteps[step] {
+		handleError(http.StatusBadRequest, "invalid step: must be *DAYS, *WEEKS, *MONTHS or *BUSINESSDAYS")
+		return
+	}
+
+	interval := input.Interval
+	if interval == 0 {
+		interval = 1
+	}
+	if interval < 1 {
+		handleError(http.StatusBadRequest, "invalid interval: must be a positive number")
+		return
+	}
+
+	outputFormat := strings.ToUpper(strings.TrimSpace(input.Output))
+	if outputFormat != "" && outputFormat != "*NDJSON" && outputFormat != "*CSV" {
+		handleError(http.StatusBadRequest, "invalid output: must be *NDJSON or *CSV")
+		return
+	}
+
+	startDate, err := resolveDate(input.Start, input.DateOptions, options)
+	if err != nil {
+		handleError(http.StatusBadRequest, "start: "+err.Error())
+		return
+	}
+
+	endDate, err := resolveDate(input.End, input.DateOptions, options)
+	if err != nil {
+		handleError(http.StatusBadRequest, "end: "+err.Error())
+		return
+	}
+
+	if endDate.Before(startDate) {
+		handleError(http.StatusBadRequest, "invalid range: end is before start")
+		return
+	}
+
+	// Any longer step gives the start alone, and a shorter one cannot overflow
+	interval = min(interval, max(hundredYearDay(endDate)-hundredYearDay(startDate), 1))
+
+	// Each business day step is walked a day at a time
+	if step == "*BUSINESSDAYS" && interval > maxBusinessDays {
+		handleError(http.StatusBadRequest, "invalid interval: must be at most "+strconv.Itoa(maxBusinessDays)+" for *BUSINESSDAYS")
+		return
+	}
+
+	var writeRow func(models.OutputResults) error
+	var flush func() error
+	if outputFormat == "*CSV" {
+		context.Header("Content-Type", "text/csv")
+		writer := csv.NewWriter(context.Writer)
+		writer.Write(csvColumns())
+		writeRow = func(row models.OutputResults) error { return writer.Write(csvRecord(row)) }
+		flush = func() error { writer.Flush(); return writer.Error() }
+	} else {
+		context.Header("Content-Type", "application/x-ndjson")
+		encoder := json.NewEncoder(context.Writer)
+		writeRow = func(row models.OutputResults) error { return encoder.Encode(row) }
+		flush = func() error { return nil }
+	}
+	context.Status(http.StatusOK)
+
+	rows := 0
+	enumerateDates(startDate, endDate, step, interval, options, func(date time.Time) bool {
+		if err := writeRow(calcDatesByCalendarDate(date.Format("1/2/2006"), options)); err != nil {
+			return false
+		}
+
+		rows++
+		if rows%rangeFlushRows == 0 {
+			if err := flush(); err != nil {
+				return false
+			}
+			context.Writer.Flush()
+		}
+
+		// Stop as soon as the client goes away
+		return context.Request.Context().Err() == nil
+	})
+
+	flush()
+	context.Writer.Flush()
+}
+
+// Calls emit for each date from start through end until it returns false.
+// Months are counted from start so month ends are not lost along the way.
+func enumerateDates(start time.Time, end time.Time, step string, interval int, options conversionOptions, emit func(time.Time) bool) {
+	if step == "*BUSINESSDAYS" {
+		start = options.calendar.Roll(start, holiday.Following, options.weekend)
+	}
+
+	for i, date := 0, start; !date.After(end) && date.Year() >= 1 && date.Year() <= 9999; i++ {
+		if !emit(date) {
+			return
+		}
+
+		switch step {
+		case "*DAYS":
+			date = date.AddDate(0, 0, interval)
+		case "*WEEKS":
+			date = date.AddDate(0, 0, interval*7)
+		case "*MONTHS":
+			date, _ = addMonths(start, (i+1)*interval, "*CLAMP")
+		case "*BUSINESSDAYS":
+			date, _ = options.calendar.AddBusinessDays(date, interval, options.weekend)
+		}
+	}
+}
+
+// Every plain field of the conversion output, named by its JSON key
+func csvColumns() []string {
+	var columns []string
+	resultType := reflect.TypeOf(models.OutputResults{})
+	for i := 0; i < resultType.NumField(); i++ {
+		if isCSVField(resultType.Field(i)) {
+			columns = append(columns, strings.Split(resultType.Field(i).Tag.Get("json"), ",")[0])
+		}
+	}
+
+	return columns
+}
+
+func csvRecord(output models.OutputResults) []string {
+	var record []string
+	value := reflect.ValueOf(output)
+	for i := 0; i < value.NumField(); i++ {
+		if isCSVField(value.Type().Field(i)) {
+			record = append(record, csvValue(value.Field(i)))
+		}
+	}
+
+	return record
+}
+
+func isCSVField(field reflect.StructField) bool {
+	switch field.Type.Kind() {
+	case reflect.String, reflect.Int, reflect.Bool:
+		return true
+	}
+
+	return false
+}
+
+func csvValue(value reflect.Value) string {
+	switch value.Kind() {
+	case reflect.Int:
+		return strconv.Itoa(int(value.Int()))
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool())
+	}
+
+	return value.String()
+}
@@ -0,0 +1,158 @@
+package controller
+
+import (
+	"bufio"
+	"date_calculation/models"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateRange(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/DateRange", DateRange)
+
+	testCases := []struct {
+		name              string
+		payload           string
+		expectedStatus    int
+		expectedDates     []string
+		expectedErrorText string
+	}{
+		{
+			name:           "Days",
+			payload:        `{"start": "2023-08-30", "end": "2023-09-02"}`,
+			expectedStatus: http.StatusOK,
+			expectedDates:  []string{"2023-08-30", "2023-08-31", "2023-09-01", "2023-09-02"},
+		},
+		{
+			name:           "Every other week",
+			payload:        `{"start": "2023-08-01", "end": "2023-09-12", "step": "*WEEKS", "interval": 2}`,
+			expectedStatus: http.StatusOK,
+			expectedDates:  []string{"2023-08-01", "2023-08-15", "2023-08-29", "2023-09-12"},
+		},
+		{
+			name:           "Months keep the month end",
+			payload:        `{"start": "2023-01-31", "end": "2023-05-31", "step": "*MONTHS"}`,
+			expectedStatus: http.StatusOK,
+			expectedDates:  []string{"2023-01-31", "2023-02-28", "2023-03-31", "2023-04-30", "2023-05-31"},
+		},
+		{
+			name:           "Business days",
+			payload:        `{"start": "2023-07-01", "end": "2023-07-07", "step": "*BUSINESSDAYS"}`,
+			expectedStatus: http.StatusOK,
+			expectedDates:  []string{"2023-07-03", "2023-07-05", "2023-07-06", "2023-07-07"},
+		},
+		{
+			name:           "Interval longer than the range",
+			payload:        `{"start": "2023-01-01", "end": "2023-12-31", "step": "*MONTHS", "interval": 4611686018427387904}`,
+			expectedStatus: http.StatusOK,
+			expectedDates:  []string{"2023-01-01"},
+		},
+		{
+			name:           "Days interval longer than the range",
+			payload:        `{"start": "9999-12-30", "end": "9999-12-31", "interval": 9000000000000000000}`,
+			expectedStatus: http.StatusOK,
+			expectedDates:  []string{"9999-12-30", "9999-12-31"},
+		},
+		{
+			name:              "Business day interval too long",
+			payload:           `{"start": "0001-01-01", "end": "9999-12-31", "step": "*BUSINESSDAYS", "interval": 3000000}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid interval: must be at most 100000 for *BUSINESSDAYS",
+		},
+		{
+			name:           "HYD input",
+			payload:        `{"start": "45189", "end": "45190", "format": "*HYD"}`,
+			expectedStatus: http.StatusOK,
+			expectedDates:  []string{"2023-09-21", "2023-09-22"},
+		},
+		{
+			name:              "End before start",
+			payload:           `{"start": "2023-09-02", "end": "2023-08-30"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid range: end is before start",
+		},
+		{
+			name:              "Unknown step",
+			payload:           `{"start": "2023-08-30", "end": "2023-09-02", "step": "*YEARS"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid step: must be *DAYS, *WEEKS, *MONTHS or *BUSINESSDAYS",
+		},
+		{
+			name:              "Unknown output",
+			payload:           `{"start": "2023-08-30", "end": "2023-09-02", "output": "*XML"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid output: must be *NDJSON or *CSV",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/DateRange", strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+
+			if tc.expectedErrorText != "" {
+				var responseWrapper ResponseWrapper
+				err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedErrorText, responseWrapper.Results.ErrorText)
+				return
+			}
+
+			assert.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+
+			var dates []string
+			scanner := bufio.NewScanner(w.Body)
+			for scanner.Scan() {
+				var row models.OutputResults
+				assert.NoError(t, json.Unmarshal(scanner.Bytes(), &row))
+				dates = append(dates, row.InternationalStandard)
+			}
+			assert.Equal(t, tc.expectedDates, dates)
+		})
+	}
+}
+
+func TestDateRange_CSV(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/DateRange", DateRange)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/api/DateRange",
+		strings.NewReader(`{"start": "2020-01-01", "end": "2029-12-31", "output": "*CSV"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/csv", w.Header().Get("Content-Type"))
+
+	records, err := csv.NewReader(w.Body).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, records, 3653+1)
+	assert.Equal(t, csvColumns(), records[0])
+
+	columns := map[string]int{}
+	for i, column := range records[0] {
+		columns[column] = i
+	}
+	assert.Equal(t, "43830", records[1][columns["AcscHundredYear"]])
+	assert.Equal(t, "20-001", records[1][columns["AcscJulian"]])
+	assert.Equal(t, "2029-12-31", records[3653][columns["InternationalStandard"]])
+	assert.Equal(t, "false", records[3653][columns["IsHoliday"]])
+	assert.NotContains(t, records[0], "Representable")
+}
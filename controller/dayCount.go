@@ -0,0 +1,147 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func DayCount(context *gin.Context) {
+	var input models.InputDayCount
+	var output models.OutputDayCount
+
+	handleError := func(status int, errorString string) {
+		output.ErrorFlag = "HTTP " + strconv.Itoa(status)
+		output.ErrorText = errorString
+		context.JSON(status, gin.H{"results": output})
+	}
+
+	if err := context.ShouldBindJSON(&input); err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	options, err := newConversionOptions(input.ConversionOptions)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	fromDate, err := resolveDate(input.From, input.DateOptions, options)
+	if err != nil {
+		handleError(http.StatusBadRequest, "from: "+err.Error())
+		return
+	}
+
+	toDate, err := resolveDate(input.To, input.DateOptions, options)
+	if err != nil {
+		handleError(http.StatusBadRequest, "to: "+err.Error())
+		return
+	}
+
+	output = calcDayCounts(fromDate, toDate, input.Maturity)
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"results": output,
+		"from":    calcDatesByCalendarDate(fromDate.Format("1/2/2006"), options),
+		"to":      calcDatesByCalendarDate(toDate.Format("1/2/2006"), options),
+	})
+}
+
+// Counts are signed: negative when to is before from
+func calcDayCounts(fromDate time.Time, toDate time.Time, maturity bool) models.OutputDayCount {
+	var output models.OutputDayCount
+
+	sign := 1
+	start, end := fromDate, toDate
+	if toDate.Before(fromDate) {
+		sign = -1
+		start, end = toDate, fromDate
+	}
+
+	actual := hundredYearDay(end) - hundredYearDay(start)
+	thirty360US := days30360US(start, end)
+	thirtyE360 := days30E360(start, end)
+	thirtyE360ISDA := days30E360ISDA(start, end, maturity && sign < 0, maturity && sign > 0)
+
+	output.ActualDays = sign * actual
+	output.Conventions = []models.DayCount{
+		{Convention: "30/360 US", Days: sign * thirty360US, YearFraction: float64(sign*thirty360US) / 360},
+		{Convention: "30E/360", Days: sign * thirtyE360, YearFraction: float64(sign*thirtyE360) / 360},
+		{Convention: "30E/360 ISDA", Days: sign * thirtyE360ISDA, YearFraction: float64(sign*thirtyE360ISDA) / 360},
+		{Convention: "ACT/360", Days: sign * actual, YearFraction: float64(sign*actual) / 360},
+		{Convention: "ACT/365F", Days: sign * actual, YearFraction: float64(sign*actual) / 365},
+		{Convention: "ACT/ACT ISDA", Days: sign * actual, YearFraction: float64(sign) * actActISDA(start, end)},
+	}
+	output.ErrorFlag = "0"
+
+	return output
+}
+
+func days360(y1 int, m1 time.Month, d1 int, y2 int, m2 time.Month, d2 int) int {
+	return 360*(y2-y1) + 30*int(m2-m1) + (d2 - d1)
+}
+
+func isLastDayOfMonth(date time.Time) bool {
+	return date.Day() == daysInMonth(date.Year(), date.Month())
+}
+
+func isLastDayOfFebruary(date time.Time) bool {
+	return date.Month() == time.February && isLastDayOfMonth(date)
+}
+
+func days30360US(start time.Time, end time.Time) int {
+	d1, d2 := start.Day(), end.Day()
+
+	if isLastDayOfFebruary(start) && isLastDayOfFebruary(end) {
+		d2 = 30
+	}
+	if isLastDayOfFebruary(start) {
+		d1 = 30
+	}
+	if d2 == 31 && d1 >= 30 {
+		d2 = 30
+	}
+	if d1 == 31 {
+		d1 = 30
+	}
+
+	return days360(start.Year(), start.Month(), d1, end.Year(), end.Month(), d2)
+}
+
+func days30E360(start time.Time, end time.Time) int {
+	return days360(start.Year(), start.Month(), min(start.Day(), 30), end.Year(), end.Month(), min(end.Day(), 30))
+}
+
+// February month ends count as the 30th, except on the maturity date
+func days30E360ISDA(start time.Time, end time.Time, startMaturity bool, endMaturity bool) int {
+	d1, d2 := start.Day(), end.Day()
+
+	if isLastDayOfMonth(start) && !(startMaturity && start.Month() == time.February) {
+		d1 = 30
+	}
+	if isLastDayOfMonth(end) && !(endMaturity && end.Month() == time.February) {
+		d2 = 30
+	}
+
+	return days360(start.Year(), start.Month(), d1, end.Year(), end.Month(), d2)
+}
+
+// Days in each calendar year are divided by that year's length
+func actActISDA(start time.Time, end time.Time) float64 {
+	if start.Year() == end.Year() {
+		return float64(hundredYearDay(end)-hundredYearDay(start)) / float64(daysInYear(start.Year()))
+	}
+
+	nextYear := time.Date(start.Year()+1, 1, 1, 0, 0, 0, 0, time.UTC)
+	lastYear := time.Date(end.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+
+	fraction := float64(hundredYearDay(nextYear)-hundredYearDay(start)) / float64(daysInYear(start.Year()))
+	fraction += float64(end.Year() - start.Year() - 1)
+	fraction += float64(hundredYearDay(end)-hundredYearDay(lastYear)) / float64(daysInYear(end.Year()))
+
+	return fraction
+}
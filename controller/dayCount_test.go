@@ -0,0 +1,126 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDayCount(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/DayCount", DayCount)
+
+	testCases := []struct {
+		name              string
+		payload           string
+		expectedStatus    int
+		expectedActual    int
+		expectedDays      []int // 30/360 US, 30E/360, 30E/360 ISDA, ACT/360, ACT/365F, ACT/ACT ISDA
+		expectedActAct    float64
+		expectedErrorText string
+	}{
+		{
+			name:           "Across a year end",
+			payload:        `{"from": "2007-12-28", "to": "2008-02-28"}`,
+			expectedStatus: http.StatusOK,
+			expectedActual: 62,
+			expectedDays:   []int{60, 60, 60, 62, 62, 62},
+			expectedActAct: 4.0/365 + 58.0/366,
+		},
+		{
+			name:           "Ending on a leap day",
+			payload:        `{"from": "2007-12-28", "to": "2008-02-29"}`,
+			expectedStatus: http.StatusOK,
+			expectedActual: 63,
+			expectedDays:   []int{61, 61, 62, 63, 63, 63},
+			expectedActAct: 4.0/365 + 59.0/366,
+		},
+		{
+			name:           "Starting on the 31st",
+			payload:        `{"from": "2007-10-31", "to": "2008-11-30"}`,
+			expectedStatus: http.StatusOK,
+			expectedActual: 396,
+			expectedDays:   []int{390, 390, 390, 396, 396, 396},
+			expectedActAct: 62.0/365 + 334.0/366,
+		},
+		{
+			name:           "February month ends",
+			payload:        `{"from": "2008-02-29", "to": "2009-02-28"}`,
+			expectedStatus: http.StatusOK,
+			expectedActual: 365,
+			expectedDays:   []int{360, 359, 360, 365, 365, 365},
+			expectedActAct: 307.0/366 + 58.0/365,
+		},
+		{
+			name:           "February maturity",
+			payload:        `{"from": "2008-02-29", "to": "2009-02-28", "maturity": true}`,
+			expectedStatus: http.StatusOK,
+			expectedActual: 365,
+			expectedDays:   []int{360, 359, 358, 365, 365, 365},
+			expectedActAct: 307.0/366 + 58.0/365,
+		},
+		{
+			name:           "February maturity backward",
+			payload:        `{"from": "2009-02-28", "to": "2008-02-29", "maturity": true}`,
+			expectedStatus: http.StatusOK,
+			expectedActual: -365,
+			expectedDays:   []int{-360, -359, -361, -365, -365, -365},
+			expectedActAct: -(307.0/366 + 58.0/365),
+		},
+		{
+			name:           "Backward",
+			payload:        `{"from": "2023-07-15", "to": "2023-01-15"}`,
+			expectedStatus: http.StatusOK,
+			expectedActual: -181,
+			expectedDays:   []int{-180, -180, -180, -181, -181, -181},
+			expectedActAct: -181.0 / 365,
+		},
+		{
+			name:              "Missing from",
+			payload:           `{"to": "2023-01-15"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "from: invalid date: empty",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/DayCount", strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+
+			var responseWrapper struct {
+				Results models.OutputDayCount `json:"results"`
+			}
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+			assert.NoError(t, err)
+
+			results := responseWrapper.Results
+			assert.Equal(t, tc.expectedActual, results.ActualDays)
+			assert.Equal(t, tc.expectedErrorText, results.ErrorText)
+
+			var days []int
+			for _, convention := range results.Conventions {
+				days = append(days, convention.Days)
+			}
+			assert.Equal(t, tc.expectedDays, days)
+
+			if len(results.Conventions) == 6 {
+				assert.InDelta(t, float64(tc.expectedDays[0])/360, results.Conventions[0].YearFraction, 1e-12)
+				assert.InDelta(t, float64(tc.expectedActual)/365, results.Conventions[4].YearFraction, 1e-12)
+				assert.InDelta(t, tc.expectedActAct, results.Conventions[5].YearFraction, 1e-12)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package controller
+
+import (
+	"date_calculation/cvtdat"
+	"date_calculation/models"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Tried in order; the first format producing a given date names it
+var separatedFormats = []string{
+	cvtdat.ISO, cvtdat.USA, cvtdat.EUR,
+	cvtdat.YYMD, cvtdat.MDYY, cvtdat.DMYY,
+	cvtdat.CYMD, cvtdat.LONGJUL,
+	cvtdat.MDY, cvtdat.DMY, cvtdat.YMD, cvtdat.JUL,
+}
+
+var numericFormats = []string{
+	cvtdat.YYMD, cvtdat.MDYY, cvtdat.DMYY,
+	cvtdat.CYMD, cvtdat.LONGJUL,
+	cvtdat.MDY, cvtdat.DMY, cvtdat.YMD, cvtdat.JUL,
+}
+
+var formatOrder = map[string]string{
+	isoWeekFormat: "YMD",
+	cvtdat.ISO:    "YMD",
+	cvtdat.JIS:    "YMD",
+	cvtdat.YYMD:   "YMD",
+	cvtdat.CYMD:   "YMD",
+	cvtdat.YMD:    "YMD",
+	cvtdat.USA:    "MDY",
+	cvtdat.MDYY:   "MDY",
+	cvtdat.CMDY:   "MDY",
+	cvtdat.MDY:    "MDY",
+	cvtdat.EUR:    "DMY",
+	cvtdat.DMYY:   "DMY",
+	cvtdat.CDMY:   "DMY",
+	cvtdat.DMY:    "DMY",
+}
+
+// Regions writing month first, and languages writing year first; everyone
+// else is treated as day first
+var monthFirstRegions = map[string]bool{"US": true, "PH": true, "FM": true, "MH": true, "PW": true}
+var yearFirstLanguages = map[string]bool{"ja": true, "zh": true, "ko": true, "hu": true, "lt": true, "mn": true}
+
+type dateCandidate struct {
+	format string
+	date   time.Time
+}
+
+func detectDate(inputDate string, window cvtdat.Window) []dateCandidate {
+	inputDate = strings.TrimSpace(inputDate)
+
+	if weekDate, ok := parseIsoWeekDate(inputDate); ok {
+		return []dateCandidate{{format: isoWeekFormat, date: weekDate}}
+	}
+
+	formats := numericFormats
+	if strings.ContainsAny(inputDate, "/-., ") {
+		formats = separatedFormats
+	}
+
+	var candidates []dateCandidate
+	seen := map[time.Time]bool{}
+	for _, format := range formats {
+		parsedDate, err := cvtdat.ParseInWindow(inputDate, format, window)
+		if err != nil || seen[parsedDate] {
+			continue
+		}
+
+		seen[parsedDate] = true
+		candidates = append(candidates, dateCandidate{format: format, date: parsedDate})
+	}
+
+	return candidates
+}
+
+// Narrows candidates to those written in the preferred order, leaving them
+// untouched when none match
+func preferOrder(candidates []dateCandidate, order string) []dateCandidate {
+	if order == "" || len(candidates) < 2 {
+		return candidates
+	}
+
+	var preferred []dateCandidate
+	for _, candidate := range candidates {
+		if formatOrder[candidate.format] == order {
+			preferred = append(preferred, candidate)
+		}
+	}
+
+	if len(preferred) == 0 {
+		return candidates
+	}
+
+	return preferred
+}
+
+func resolveOrder(order string, locale string) string {
+	order = strings.ToUpper(strings.TrimSpace(order))
+	if order != "" || locale == "" {
+		return order
+	}
+
+	tags := strings.FieldsFunc(locale, func(r rune) bool { return r == '-' || r == '_' })
+	if len(tags) > 1 && monthFirstRegions[strings.ToUpper(tags[len(tags)-1])] {
+		return "MDY"
+	}
+	if yearFirstLanguages[strings.ToLower(tags[0])] {
+		return "YMD"
+	}
+
+	return "DMY"
+}
+
+func formatNames(candidates []dateCandidate) string {
+	names := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		names[i] = candidate.format
+	}
+
+	return strings.Join(names, ", ")
+}
+
+func detectCalendarDate(context *gin.Context, input models.InputCalendarDate, options conversionOptions) {
+	var output models.OutputResults
+
+	handleError := func(status int, errorString string) {
+		output.ErrorFlag = "HTTP " + strconv.Itoa(status)
+		output.ErrorText = errorString
+		context.JSON(status, gin.H{"results": output})
+	}
+
+	order := resolveOrder(input.Order, input.Locale)
+	if order != "" && order != "MDY" && order != "DMY" && order != "YMD" {
+		handleError(http.StatusBadRequest, "invalid order: must be MDY, DMY or YMD")
+		return
+	}
+
+	candidates := preferOrder(detectDate(input.Date, options.window), order)
+	if len(candidates) == 0 {
+		if relativeCalendarDate(context, input, options, false) {
+			return
+		}
+		handleError(http.StatusBadRequest, "invalid date: unrecognized format: "+input.Date)
+		return
+	}
+
+	interpretations := make([]models.DetectedDate, len(candidates))
+	for i, candidate := range candidates {
+		interpretations[i] = models.DetectedDate{
+			Format:  candidate.format,
+			Results: calcDatesByCalendarDate(candidate.date.Format("1/2/2006"), options),
+		}
+	}
+
+	if len(candidates) > 1 {
+		output.ErrorFlag = "HTTP " + strconv.Itoa(http.StatusMultipleChoices)
+		output.ErrorText = "ambiguous date: " + input.Date + " matches " + formatNames(candidates)
+		context.IndentedJSON(http.StatusMultipleChoices, gin.H{"results": output, "interpretations": interpretations})
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, withAdjusted(gin.H{
+		"results":         interpretations[0].Results,
+		"detectedFormat":  interpretations[0].Format,
+		"interpretations": interpretations,
+	}, candidates[0].date.Format("1/2/2006"), options))
+}
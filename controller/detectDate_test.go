@@ -0,0 +1,174 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalcCalendarDate_AutoDetect(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/CalcCalendarDate", CalcCalendarDate)
+
+	testCases := []struct {
+		name                    string
+		payload                 string
+		expectedStatus          int
+		expectedFormat          string
+		expectedErrorText       string
+		expectedInterpretations []string
+	}{
+		{
+			name:                    "ISO",
+			payload:                 `{"date": "2023-08-31", "mode": "auto"}`,
+			expectedStatus:          http.StatusOK,
+			expectedFormat:          "*ISO",
+			expectedInterpretations: []string{"2023-08-31"},
+		},
+		{
+			name:                    "European",
+			payload:                 `{"date": "31.08.2023", "mode": "auto"}`,
+			expectedStatus:          http.StatusOK,
+			expectedFormat:          "*EUR",
+			expectedInterpretations: []string{"2023-08-31"},
+		},
+		{
+			name:                    "YYYYMMDD",
+			payload:                 `{"date": "20230831", "mode": "auto"}`,
+			expectedStatus:          http.StatusOK,
+			expectedFormat:          "*YYMD",
+			expectedInterpretations: []string{"2023-08-31"},
+		},
+		{
+			name:                    "CYYMMDD",
+			payload:                 `{"date": "1230831", "mode": "auto"}`,
+			expectedStatus:          http.StatusOK,
+			expectedFormat:          "*CYMD",
+			expectedInterpretations: []string{"2023-08-31"},
+		},
+		{
+			name:                    "Long julian",
+			payload:                 `{"date": "2023243", "mode": "auto"}`,
+			expectedStatus:          http.StatusOK,
+			expectedFormat:          "*LONGJUL",
+			expectedInterpretations: []string{"2023-08-31"},
+		},
+		{
+			name:                    "ISO week date",
+			payload:                 `{"date": "2023-W28-6", "mode": "auto"}`,
+			expectedStatus:          http.StatusOK,
+			expectedFormat:          "*ISOWEEK",
+			expectedInterpretations: []string{"2023-07-15"},
+		},
+		{
+			name:                    "Basic ISO week date in the previous year",
+			payload:                 `{"date": "2020W537", "mode": "auto"}`,
+			expectedStatus:          http.StatusOK,
+			expectedFormat:          "*ISOWEEK",
+			expectedInterpretations: []string{"2021-01-03"},
+		},
+		{
+			name:                    "ISO week without a weekday",
+			payload:                 `{"date": "2025-W01", "mode": "auto"}`,
+			expectedStatus:          http.StatusOK,
+			expectedFormat:          "*ISOWEEK",
+			expectedInterpretations: []string{"2024-12-30"},
+		},
+		{
+			name:                    "ISO ordinal date",
+			payload:                 `{"date": "2024-366", "mode": "auto"}`,
+			expectedStatus:          http.StatusOK,
+			expectedFormat:          "*LONGJUL",
+			expectedInterpretations: []string{"2024-12-31"},
+		},
+		{
+			name:              "ISO week 53 in a 52 week year",
+			payload:           `{"date": "2021-W53-1", "mode": "auto"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid date: unrecognized format: 2021-W53-1",
+		},
+		{
+			name:                    "Ambiguous short date",
+			payload:                 `{"date": "01/02/03", "mode": "auto"}`,
+			expectedStatus:          http.StatusMultipleChoices,
+			expectedErrorText:       "ambiguous date: 01/02/03 matches *MDY, *DMY, *YMD",
+			expectedInterpretations: []string{"2003-01-02", "2003-02-01", "2001-02-03"},
+		},
+		{
+			name:                    "Ambiguous short date with order",
+			payload:                 `{"date": "01/02/03", "mode": "auto", "order": "dmy"}`,
+			expectedStatus:          http.StatusOK,
+			expectedFormat:          "*DMY",
+			expectedInterpretations: []string{"2003-02-01"},
+		},
+		{
+			name:                    "Ambiguous short date with US locale",
+			payload:                 `{"date": "01/02/03", "mode": "auto", "locale": "en-US"}`,
+			expectedStatus:          http.StatusOK,
+			expectedFormat:          "*MDY",
+			expectedInterpretations: []string{"2003-01-02"},
+		},
+		{
+			name:                    "Ambiguous short date with Japanese locale",
+			payload:                 `{"date": "01/02/03", "mode": "auto", "locale": "ja-JP"}`,
+			expectedStatus:          http.StatusOK,
+			expectedFormat:          "*YMD",
+			expectedInterpretations: []string{"2001-02-03"},
+		},
+		{
+			name:                    "Order not matching any interpretation keeps them all",
+			payload:                 `{"date": "08/31/2023", "mode": "auto", "order": "DMY"}`,
+			expectedStatus:          http.StatusOK,
+			expectedFormat:          "*USA",
+			expectedInterpretations: []string{"2023-08-31"},
+		},
+		{
+			name:              "Unrecognized",
+			payload:           `{"date": "abcde", "mode": "auto"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid date: unrecognized format: abcde",
+		},
+		{
+			name:              "Invalid order",
+			payload:           `{"date": "01/02/03", "mode": "auto", "order": "XYZ"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid order: must be MDY, DMY or YMD",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/CalcCalendarDate", strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+
+			var responseWrapper struct {
+				Results         models.OutputResults  `json:"results"`
+				DetectedFormat  string                `json:"detectedFormat"`
+				Interpretations []models.DetectedDate `json:"interpretations"`
+			}
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedFormat, responseWrapper.DetectedFormat)
+			assert.Equal(t, tc.expectedErrorText, responseWrapper.Results.ErrorText)
+
+			var interpretations []string
+			for _, interpretation := range responseWrapper.Interpretations {
+				interpretations = append(interpretations, interpretation.Results.InternationalStandard)
+			}
+			assert.Equal(t, tc.expectedInterpretations, interpretations)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// Format name reported when auto-detect reads an ISO 8601 week date
+const isoWeekFormat = "*ISOWEEK"
+
+// 2023-W28-6 or 2023W286; the weekday may be left off to mean Monday
+var isoWeekPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`^(\d{4})-[Ww](\d{2})(?:-([1-7]))?$`),
+	regexp.MustCompile(`^(\d{4})[Ww](\d{2})([1-7])?$`),
+}
+
+// Monday is day 1, Sunday day 7
+func isoWeekday(date time.Time) int {
+	return (int(date.Weekday())+6)%7 + 1
+}
+
+// December 28 always falls in the last ISO week of its year
+func isoWeeksInYear(year int) int {
+	_, weeks := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return weeks
+}
+
+func parseIsoWeekDate(inputDate string) (time.Time, bool) {
+	for _, pattern := range isoWeekPatterns {
+		match := pattern.FindStringSubmatch(inputDate)
+		if match == nil {
+			continue
+		}
+
+		year, _ := strconv.Atoi(match[1])
+		week, _ := strconv.Atoi(match[2])
+		weekday := 1
+		if match[3] != "" {
+			weekday, _ = strconv.Atoi(match[3])
+		}
+
+		if year < 1 || week < 1 || week > isoWeeksInYear(year) {
+			return time.Time{}, false
+		}
+
+		// January 4 always falls in week 1
+		january4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+		date := january4.AddDate(0, 0, (week-1)*7+weekday-isoWeekday(january4))
+		if date.Year() < 1 || date.Year() > 9999 {
+			return time.Time{}, false
+		}
+
+		return date, true
+	}
+
+	return time.Time{}, false
+}
+
+func calcIsoWeek(inputDate string) (int, int, int, string) {
+	parsedDate, err := time.Parse("1/2/2006", inputDate)
+	if err != nil {
+		fmt.Println("Error:", err)
+		return 0, 0, 0, ""
+	}
+
+	year, week := parsedDate.ISOWeek()
+	weekday := isoWeekday(parsedDate)
+
+	return year, week, weekday, fmt.Sprintf("%04d-W%02d-%d", year, week, weekday)
+}
+
+// Weeks start on Sunday and week 1 is the one holding January 1, as Db2's
+// WEEK function counts them
+func calcUsWeekOfYear(inputDate string) int {
+	parsedDate, err := time.Parse("1/2/2006", inputDate)
+	if err != nil {
+		fmt.Println("Error:", err)
+		return 0
+	}
+
+	january1 := time.Date(parsedDate.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	return (parsedDate.YearDay()-1+int(january1.Weekday()))/7 + 1
+}
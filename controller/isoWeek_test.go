@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsoWeekOutput(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/CalcCalendarDate", CalcCalendarDate)
+
+	testCases := []struct {
+		date                 string
+		expectedWeekDate     string
+		expectedWeekYear     int
+		expectedWeek         int
+		expectedWeekday      int
+		expectedUsWeekOfYear int
+	}{
+		{"7/15/2023", "2023-W28-6", 2023, 28, 6, 28},
+		{"1/1/2021", "2020-W53-5", 2020, 53, 5, 1},
+		{"1/2/2021", "2020-W53-6", 2020, 53, 6, 1},
+		{"1/3/2021", "2020-W53-7", 2020, 53, 7, 2},
+		{"12/31/2024", "2025-W01-2", 2025, 1, 2, 53},
+		{"1/1/2023", "2022-W52-7", 2022, 52, 7, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.date, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/CalcCalendarDate", strings.NewReader(`{"date": "`+tc.date+`"}`))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var responseWrapper ResponseWrapper
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedWeekDate, responseWrapper.Results.IsoWeekDate)
+			assert.Equal(t, tc.expectedWeekYear, responseWrapper.Results.IsoWeekYear)
+			assert.Equal(t, tc.expectedWeek, responseWrapper.Results.IsoWeek)
+			assert.Equal(t, tc.expectedWeekday, responseWrapper.Results.IsoWeekday)
+			assert.Equal(t, tc.expectedUsWeekOfYear, responseWrapper.Results.UsWeekOfYear)
+		})
+	}
+}
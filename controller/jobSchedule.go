@@ -0,0 +1,326 @@
+package controller
+
+import (
+	"date_calculation/clock"
+	"date_calculation/holiday"
+	"date_calculation/models"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultScheduledRuns = 10
+	maxScheduledRuns     = 100
+	maxOmitDates         = 20
+)
+
+// SCDDAY names in the order ADDJOBSCDE lists them
+var scheduleDayNames = []string{"*MON", "*TUE", "*WED", "*THU", "*FRI", "*SAT", "*SUN"}
+
+// An ADDJOBSCDE schedule. date is set for a specific SCDDATE, dateRule
+// for *MONTHSTR or *MONTHEND and days for SCDDAY; relative holds the
+// RELDAYMON weeks, -1 for *LAST.
+type jobSchedule struct {
+	frequency string
+	date      time.Time
+	dateRule  string
+	days      map[time.Weekday]bool
+	relative  []int
+	omit      map[time.Time]bool
+}
+
+func JobSchedule(context *gin.Context) {
+	var input models.InputJobSchedule
+	var output models.OutputJobSchedule
+
+	handleError := func(status int, errorString string) {
+		output.ErrorFlag = "HTTP " + strconv.Itoa(status)
+		output.ErrorText = errorString
+		context.JSON(status, gin.H{"results": output})
+	}
+
+	if err := context.ShouldBindJSON(&input); err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	options, err := newConversionOptions(input.ConversionOptions)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	runs := input.Runs
+	if runs == 0 {
+		runs = defaultScheduledRuns
+	}
+	if runs < 1 || runs > maxScheduledRuns {
+		handleError(http.StatusBadRequest, "invalid runs: must be between 1 and "+strconv.Itoa(maxScheduledRuns))
+		return
+	}
+
+	currentDate, _ := jobDate(strings.TrimSpace(input.Session))
+
+	schedule, err := newJobSchedule(input, currentDate, options)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	from := currentDate
+	if input.ScdTime != "" {
+		scheduledTime, err := parseScheduleTime(input.ScdTime)
+		if err != nil {
+			handleError(http.StatusBadRequest, err.Error())
+			return
+		}
+
+		now := clock.Now()
+		if now.Hour()*3600+now.Minute()*60+now.Second() > scheduledTime {
+			from = from.AddDate(0, 0, 1)
+		}
+	}
+
+	if schedule.frequency == "*ONCE" && !schedule.date.IsZero() && schedule.date.Before(from) {
+		handleError(http.StatusBadRequest, "invalid scdDate: a *ONCE job cannot be scheduled in the past")
+		return
+	}
+
+	var dates []models.OutputResults
+	for _, date := range schedule.next(from, runs) {
+		dates = append(dates, calcDatesByCalendarDate(date.Format("1/2/2006"), options))
+	}
+
+	output.Command = schedule.command(input.ScdDate)
+	output.Count = len(dates)
+	output.CurrentDate = currentDate.Format("01022006")
+	output.ErrorFlag = "0"
+
+	context.IndentedJSON(http.StatusOK, gin.H{"results": output, "runs": dates})
+}
+
+func newJobSchedule(input models.InputJobSchedule, currentDate time.Time, options conversionOptions) (jobSchedule, error) {
+	schedule := jobSchedule{
+		frequency: strings.ToUpper(strings.TrimSpace(input.Frequency)),
+		days:      map[time.Weekday]bool{},
+		omit:      map[time.Time]bool{},
+	}
+
+	if schedule.frequency != "*ONCE" && schedule.frequency != "*WEEKLY" && schedule.frequency != "*MONTHLY" {
+		return schedule, errors.New("invalid frq: must be *ONCE, *WEEKLY or *MONTHLY")
+	}
+
+	for _, name := range input.ScdDay {
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if name == "*NONE" && len(input.ScdDay) == 1 {
+			break
+		}
+		if name == "*ALL" {
+			if len(input.ScdDay) > 1 {
+				return schedule, errors.New("invalid scdDay: *ALL cannot be listed with other days")
+			}
+			for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+				schedule.days[weekday] = true
+			}
+			continue
+		}
+
+		weekday, ok := holiday.ParseWeekday(name)
+		if !ok || !strings.HasPrefix(name, "*") || len(name) != 4 {
+			return schedule, errors.New("invalid scdDay: " + name + ": must be *MON through *SUN, *ALL or *NONE")
+		}
+		schedule.days[weekday] = true
+	}
+
+	scdDate := strings.ToUpper(strings.TrimSpace(input.ScdDate))
+	if scdDate == "" {
+		scdDate = "*CURRENT"
+		if len(schedule.days) > 0 {
+			scdDate = "*NONE"
+		}
+	}
+
+	switch scdDate {
+	case "*NONE":
+		if len(schedule.days) == 0 {
+			return schedule, errors.New("invalid scdDay: required when scdDate is *NONE")
+		}
+	case "*MONTHSTR", "*MONST", "*MONTHEND", "*MONEND":
+		schedule.dateRule = "*MONTHSTR"
+		if scdDate == "*MONTHEND" || scdDate == "*MONEND" {
+			schedule.dateRule = "*MONTHEND"
+		}
+		if schedule.frequency != "*MONTHLY" {
+			return schedule, errors.New("invalid scdDate: " + schedule.dateRule + " requires frq *MONTHLY")
+		}
+	case "*CURRENT":
+		schedule.date = currentDate
+	default:
+		date, err := resolveDate(input.ScdDate, input.DateOptions, options)
+		if err != nil {
+			return schedule, errors.New("invalid scdDate: " + err.Error())
+		}
+		schedule.date = date
+	}
+
+	if scdDate != "*NONE" && len(schedule.days) > 0 {
+		return schedule, errors.New("invalid scdDate: must be *NONE when scdDay is given")
+	}
+
+	for _, value := range input.RelDayMon {
+		value = strings.ToUpper(strings.TrimSpace(value))
+		if value == "*LAST" {
+			schedule.relative = append(schedule.relative, -1)
+			continue
+		}
+
+		week, err := strconv.Atoi(value)
+		if err != nil || week < 1 || week > 5 {
+			return schedule, errors.New("invalid relDayMon: " + value + ": must be 1 through 5 or *LAST")
+		}
+		schedule.relative = append(schedule.relative, week)
+	}
+
+	monthlyByDay := schedule.frequency == "*MONTHLY" && len(schedule.days) > 0
+	if len(schedule.relative) > 0 && !monthlyByDay {
+		return schedule, errors.New("invalid relDayMon: requires frq *MONTHLY and scdDay")
+	}
+	if monthlyByDay && len(schedule.relative) == 0 {
+		return schedule, errors.New("invalid relDayMon: required for frq *MONTHLY with scdDay")
+	}
+	if monthlyByDay && len(schedule.days) == 7 {
+		return schedule, errors.New("invalid scdDay: *ALL cannot be used with relDayMon")
+	}
+
+	if len(input.OmitDate) > maxOmitDates {
+		return schedule, errors.New("invalid omitDate: at most " + strconv.Itoa(maxOmitDates) + " dates")
+	}
+	for _, value := range input.OmitDate {
+		date, err := resolveDate(value, input.DateOptions, options)
+		if err != nil {
+			return schedule, errors.New("invalid omitDate: " + err.Error())
+		}
+		schedule.omit[date] = true
+	}
+
+	return schedule, nil
+}
+
+// Seconds past midnight of an SCDTIME given as HHMMSS, HHMM or HH:MM:SS
+func parseScheduleTime(value string) (int, error) {
+	value = strings.ReplaceAll(strings.TrimSpace(value), ":", "")
+	if len(value) == 4 {
+		value += "00"
+	}
+
+	scheduledTime, err := time.Parse("150405", value)
+	if err != nil {
+		return 0, errors.New("invalid scdTime: must be HHMMSS")
+	}
+
+	return scheduledTime.Hour()*3600 + scheduledTime.Minute()*60 + scheduledTime.Second(), nil
+}
+
+// Whether the schedule calls for a run on date, before omitted dates
+func (s jobSchedule) runsOn(date time.Time) bool {
+	lastDay := daysInMonth(date.Year(), date.Month())
+
+	switch {
+	case len(s.days) > 0:
+		if !s.days[date.Weekday()] {
+			return false
+		}
+		if s.frequency != "*MONTHLY" {
+			return true
+		}
+		for _, week := range s.relative {
+			if week == (date.Day()-1)/7+1 || (week == -1 && date.Day()+7 > lastDay) {
+				return true
+			}
+		}
+		return false
+	case s.dateRule == "*MONTHSTR":
+		return date.Day() == 1
+	case s.dateRule == "*MONTHEND":
+		return date.Day() == lastDay
+	case date.Before(s.date):
+		return false
+	case s.frequency == "*WEEKLY":
+		return date.Weekday() == s.date.Weekday()
+	case s.frequency == "*MONTHLY":
+		// A 29th, 30th or 31st runs on the last day of shorter months
+		return date.Day() == min(s.date.Day(), lastDay)
+	}
+
+	return date.Equal(s.date)
+}
+
+// The first runs on or after from, skipping omitted dates; a *ONCE job runs
+// at most once
+func (s jobSchedule) next(from time.Time, runs int) []time.Time {
+	if s.frequency == "*ONCE" {
+		runs = 1
+	}
+
+	var dates []time.Time
+	for date := from; len(dates) < runs && date.Year() <= 9999; date = date.AddDate(0, 0, 1) {
+		if !s.runsOn(date) {
+			continue
+		}
+
+		if !s.omit[date] {
+			dates = append(dates, date)
+		} else if s.frequency == "*ONCE" && !s.date.IsZero() {
+			break
+		}
+	}
+
+	return dates
+}
+
+// The ADDJOBSCDE parameters the schedule was read from
+func (s jobSchedule) command(scdDate string) string {
+	command := "ADDJOBSCDE FRQ(" + s.frequency + ")"
+
+	switch {
+	case len(s.days) > 0:
+		command += " SCDDATE(*NONE)"
+	case s.dateRule != "":
+		command += " SCDDATE(" + s.dateRule + ")"
+	case strings.EqualFold(strings.TrimSpace(scdDate), "*CURRENT") || strings.TrimSpace(scdDate) == "":
+		command += " SCDDATE(*CURRENT)"
+	default:
+		command += " SCDDATE('" + s.date.Format("01/02/06") + "')"
+	}
+
+	if len(s.days) == 7 {
+		command += " SCDDAY(*ALL)"
+	} else if len(s.days) > 0 {
+		var names []string
+		for _, name := range scheduleDayNames {
+			weekday, _ := holiday.ParseWeekday(name)
+			if s.days[weekday] {
+				names = append(names, name)
+			}
+		}
+		command += " SCDDAY(" + strings.Join(names, " ") + ")"
+	}
+
+	if len(s.relative) > 0 {
+		weeks := make([]string, len(s.relative))
+		for i, week := range s.relative {
+			weeks[i] = strconv.Itoa(week)
+			if week == -1 {
+				weeks[i] = "*LAST"
+			}
+		}
+		command += " RELDAYMON(" + strings.Join(weeks, " ") + ")"
+	}
+
+	return command
+}
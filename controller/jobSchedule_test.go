@@ -0,0 +1,154 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobSchedule(t *testing.T) {
+	freezeClock(t, time.Date(2023, time.August, 31, 12, 0, 0, 0, time.UTC))
+
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/JobSchedule", JobSchedule)
+
+	testCases := []struct {
+		name              string
+		payload           string
+		expectedStatus    int
+		expectedCommand   string
+		expectedDates     []string
+		expectedErrorText string
+	}{
+		{
+			name:            "Third Friday of the month",
+			payload:         `{"frq": "*MONTHLY", "scdDay": ["*FRI"], "relDayMon": ["3"], "runs": 3}`,
+			expectedStatus:  http.StatusOK,
+			expectedCommand: "ADDJOBSCDE FRQ(*MONTHLY) SCDDATE(*NONE) SCDDAY(*FRI) RELDAYMON(3)",
+			expectedDates:   []string{"2023-09-15", "2023-10-20", "2023-11-17"},
+		},
+		{
+			name:            "Last Friday of the month",
+			payload:         `{"frq": "*MONTHLY", "scdDay": ["*fri"], "relDayMon": ["*LAST"], "runs": 2}`,
+			expectedStatus:  http.StatusOK,
+			expectedCommand: "ADDJOBSCDE FRQ(*MONTHLY) SCDDATE(*NONE) SCDDAY(*FRI) RELDAYMON(*LAST)",
+			expectedDates:   []string{"2023-09-29", "2023-10-27"},
+		},
+		{
+			name:            "Monthly on the current date uses the last day of short months",
+			payload:         `{"frq": "*MONTHLY", "runs": 4}`,
+			expectedStatus:  http.StatusOK,
+			expectedCommand: "ADDJOBSCDE FRQ(*MONTHLY) SCDDATE(*CURRENT)",
+			expectedDates:   []string{"2023-08-31", "2023-09-30", "2023-10-31", "2023-11-30"},
+		},
+		{
+			name:            "Scheduled time already passed today",
+			payload:         `{"frq": "*MONTHLY", "scdDate": "*CURRENT", "scdTime": "090000", "runs": 2}`,
+			expectedStatus:  http.StatusOK,
+			expectedCommand: "ADDJOBSCDE FRQ(*MONTHLY) SCDDATE(*CURRENT)",
+			expectedDates:   []string{"2023-09-30", "2023-10-31"},
+		},
+		{
+			name:            "Month end with an omitted date",
+			payload:         `{"frq": "*MONTHLY", "scdDate": "*MONEND", "omitDate": ["2023-09-30"], "runs": 2}`,
+			expectedStatus:  http.StatusOK,
+			expectedCommand: "ADDJOBSCDE FRQ(*MONTHLY) SCDDATE(*MONTHEND)",
+			expectedDates:   []string{"2023-08-31", "2023-10-31"},
+		},
+		{
+			name:            "Weekly on two days",
+			payload:         `{"frq": "*WEEKLY", "scdDay": ["*WED", "*MON"], "runs": 3}`,
+			expectedStatus:  http.StatusOK,
+			expectedCommand: "ADDJOBSCDE FRQ(*WEEKLY) SCDDATE(*NONE) SCDDAY(*MON *WED)",
+			expectedDates:   []string{"2023-09-04", "2023-09-06", "2023-09-11"},
+		},
+		{
+			name:            "Once on a date",
+			payload:         `{"frq": "*ONCE", "scdDate": "2023-09-05"}`,
+			expectedStatus:  http.StatusOK,
+			expectedCommand: "ADDJOBSCDE FRQ(*ONCE) SCDDATE('09/05/23')",
+			expectedDates:   []string{"2023-09-05"},
+		},
+		{
+			name:              "Once in the past",
+			payload:           `{"frq": "*ONCE", "scdDate": "2023-08-30"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid scdDate: a *ONCE job cannot be scheduled in the past",
+		},
+		{
+			name:              "Monthly day without a relative week",
+			payload:           `{"frq": "*MONTHLY", "scdDay": ["*FRI"]}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid relDayMon: required for frq *MONTHLY with scdDay",
+		},
+		{
+			name:              "Relative week on a weekly job",
+			payload:           `{"frq": "*WEEKLY", "scdDay": ["*FRI"], "relDayMon": ["1"]}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid relDayMon: requires frq *MONTHLY and scdDay",
+		},
+		{
+			name:              "Date and day together",
+			payload:           `{"frq": "*WEEKLY", "scdDate": "*CURRENT", "scdDay": ["*FRI"]}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid scdDate: must be *NONE when scdDay is given",
+		},
+		{
+			name:              "Month start on a weekly job",
+			payload:           `{"frq": "*WEEKLY", "scdDate": "*MONTHSTR"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid scdDate: *MONTHSTR requires frq *MONTHLY",
+		},
+		{
+			name:              "Unknown frequency",
+			payload:           `{"frq": "*DAILY"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid frq: must be *ONCE, *WEEKLY or *MONTHLY",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/JobSchedule", strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+
+			var responseWrapper struct {
+				Results models.OutputJobSchedule `json:"results"`
+				Runs    []models.OutputResults   `json:"runs"`
+			}
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+			assert.NoError(t, err)
+
+			results := responseWrapper.Results
+			if tc.expectedErrorText != "" {
+				assert.Equal(t, "HTTP 400", results.ErrorFlag)
+				assert.Equal(t, tc.expectedErrorText, results.ErrorText)
+				return
+			}
+
+			assert.Equal(t, "0", results.ErrorFlag)
+			assert.Equal(t, tc.expectedCommand, results.Command)
+			assert.Equal(t, "08312023", results.CurrentDate)
+			assert.Equal(t, len(tc.expectedDates), results.Count)
+
+			var dates []string
+			for _, run := range responseWrapper.Runs {
+				dates = append(dates, run.InternationalStandard)
+			}
+			assert.Equal(t, tc.expectedDates, dates)
+		})
+	}
+}
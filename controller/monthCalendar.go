@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"date_calculation/holiday"
+	"date_calculation/models"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func MonthCalendar(context *gin.Context) {
+	var input models.InputMonthCalendar
+	var output models.OutputMonthCalendar
+
+	handleError := func(status int, errorString string) {
+		output.ErrorFlag = "HTTP " + strconv.Itoa(status)
+		output.ErrorText = errorString
+		context.JSON(status, gin.H{"results": output})
+	}
+
+	if err := context.ShouldBindJSON(&input); err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	options, err := newConversionOptions(input.ConversionOptions)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if input.Year < 1 || input.Year > 9999 {
+		handleError(http.StatusBadRequest, "invalid year: must be between 1 and 9999")
+		return
+	}
+
+	if input.Month < 1 || input.Month > 12 {
+		handleError(http.StatusBadRequest, "invalid month: must be between 1 and 12")
+		return
+	}
+
+	weekStart := time.Sunday
+	if input.WeekStart != "" {
+		weekday, ok := holiday.ParseWeekday(input.WeekStart)
+		if !ok {
+			handleError(http.StatusBadRequest, "invalid week start: "+input.WeekStart)
+			return
+		}
+		weekStart = weekday
+	}
+
+	output = calcMonthCalendar(input.Year, time.Month(input.Month), weekStart, options)
+	context.IndentedJSON(http.StatusOK, gin.H{"results": output})
+}
+
+// Lays the month out in rows of seven days starting on weekStart
+func calcMonthCalendar(year int, month time.Month, weekStart time.Weekday, options conversionOptions) models.OutputMonthCalendar {
+	var output models.OutputMonthCalendar
+
+	output.Year = year
+	output.Month = int(month)
+	output.MonthName = month.String()
+	for i := 0; i < 7; i++ {
+		output.Weekdays = append(output.Weekdays, weekdayAbbreviations[(weekStart+time.Weekday(i))%7])
+	}
+
+	firstDay := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	leading := (int(firstDay.Weekday()) - int(weekStart) + 7) % 7
+	lastDay := daysInMonth(year, month)
+
+	var week []*models.CalendarCell
+	for i := 0; i < leading; i++ {
+		week = append(week, nil)
+	}
+
+	for day := 1; day <= lastDay; day++ {
+		inputDate := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Format("1/2/2006")
+		cell := models.CalendarCell{
+			AcscJulian:  calcAcscJulian(inputDate),
+			Day:         day,
+			HundredYear: calcAcscHundredYear(inputDate),
+		}
+		cell.IsHoliday, cell.HolidayName, cell.IsBusinessDay = calcHoliday(inputDate, options)
+
+		week = append(week, &cell)
+		if len(week) == 7 {
+			output.Weeks = append(output.Weeks, week)
+			week = nil
+		}
+	}
+
+	if len(week) > 0 {
+		for len(week) < 7 {
+			week = append(week, nil)
+		}
+		output.Weeks = append(output.Weeks, week)
+	}
+
+	output.ErrorFlag = "0"
+
+	return output
+}
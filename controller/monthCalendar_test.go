@@ -0,0 +1,139 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonthCalendar(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/MonthCalendar", MonthCalendar)
+
+	testCases := []struct {
+		name              string
+		payload           string
+		expectedStatus    int
+		expectedWeekdays  []string
+		expectedRows      int
+		expectedFirst     [2]int // row and column of the 1st
+		expectedLast      [2]int
+		expectedLastDay   int
+		expectedErrorText string
+	}{
+		{
+			name:             "Sunday start",
+			payload:          `{"year": 2023, "month": 8}`,
+			expectedStatus:   http.StatusOK,
+			expectedWeekdays: []string{"SUN.", "MON.", "TUE.", "WED.", "THU.", "FRI.", "SAT."},
+			expectedRows:     5,
+			expectedFirst:    [2]int{0, 2},
+			expectedLast:     [2]int{4, 4},
+			expectedLastDay:  31,
+		},
+		{
+			name:             "Monday start",
+			payload:          `{"year": 2023, "month": 8, "weekStart": "MON"}`,
+			expectedStatus:   http.StatusOK,
+			expectedWeekdays: []string{"MON.", "TUE.", "WED.", "THU.", "FRI.", "SAT.", "SUN."},
+			expectedRows:     5,
+			expectedFirst:    [2]int{0, 1},
+			expectedLast:     [2]int{4, 3},
+			expectedLastDay:  31,
+		},
+		{
+			name:             "Four rows",
+			payload:          `{"year": 2015, "month": 2}`,
+			expectedStatus:   http.StatusOK,
+			expectedWeekdays: []string{"SUN.", "MON.", "TUE.", "WED.", "THU.", "FRI.", "SAT."},
+			expectedRows:     4,
+			expectedFirst:    [2]int{0, 0},
+			expectedLast:     [2]int{3, 6},
+			expectedLastDay:  28,
+		},
+		{
+			name:             "Six rows",
+			payload:          `{"year": 2023, "month": 7}`,
+			expectedStatus:   http.StatusOK,
+			expectedWeekdays: []string{"SUN.", "MON.", "TUE.", "WED.", "THU.", "FRI.", "SAT."},
+			expectedRows:     6,
+			expectedFirst:    [2]int{0, 6},
+			expectedLast:     [2]int{5, 1},
+			expectedLastDay:  31,
+		},
+		{
+			name:              "Invalid month",
+			payload:           `{"year": 2023, "month": 13}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid month: must be between 1 and 12",
+		},
+		{
+			name:              "Invalid week start",
+			payload:           `{"year": 2023, "month": 8, "weekStart": "XYZ"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid week start: XYZ",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/MonthCalendar", strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+
+			var responseWrapper struct {
+				Results models.OutputMonthCalendar `json:"results"`
+			}
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+			assert.NoError(t, err)
+
+			results := responseWrapper.Results
+			assert.Equal(t, tc.expectedErrorText, results.ErrorText)
+			assert.Equal(t, tc.expectedWeekdays, results.Weekdays)
+			assert.Len(t, results.Weeks, tc.expectedRows)
+			if tc.expectedRows == 0 {
+				return
+			}
+
+			first := results.Weeks[tc.expectedFirst[0]][tc.expectedFirst[1]]
+			last := results.Weeks[tc.expectedLast[0]][tc.expectedLast[1]]
+			assert.Equal(t, 1, first.Day)
+			assert.Equal(t, tc.expectedLastDay, last.Day)
+			for _, week := range results.Weeks {
+				assert.Len(t, week, 7)
+			}
+		})
+	}
+}
+
+func TestMonthCalendarCells(t *testing.T) {
+	calendar := calcMonthCalendar(2023, 7, 0, defaultOptions)
+
+	assert.Nil(t, calendar.Weeks[0][0])
+	assert.Equal(t, &models.CalendarCell{
+		AcscJulian:  "23-185",
+		Day:         4,
+		HolidayName: "Independence Day",
+		HundredYear: "45110",
+		IsHoliday:   true,
+	}, calendar.Weeks[1][2])
+	assert.Equal(t, &models.CalendarCell{
+		AcscJulian:    "23-186",
+		Day:           5,
+		HundredYear:   "45111",
+		IsBusinessDay: true,
+	}, calendar.Weeks[1][3])
+	assert.Equal(t, 31, calendar.Weeks[5][1].Day)
+	assert.Nil(t, calendar.Weeks[5][2])
+}
@@ -0,0 +1,172 @@
+package controller
+
+import (
+	"date_calculation/holiday"
+	"date_calculation/models"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const maxPayLag = 366
+
+var payFrequencies = map[string]int{
+	"*WEEKLY":      7,
+	"*BIWEEKLY":    14,
+	"*SEMIMONTHLY": 0,
+	"*MONTHLY":     0,
+}
+
+type payPeriod struct {
+	period        int
+	periodsInYear int
+	start         time.Time
+	end           time.Time
+}
+
+func PayPeriod(context *gin.Context) {
+	var input models.InputPayPeriod
+	var output models.OutputPayPeriod
+
+	handleError := func(status int, errorString string) {
+		output.ErrorFlag = "HTTP " + strconv.Itoa(status)
+		output.ErrorText = errorString
+		context.JSON(status, gin.H{"results": output})
+	}
+
+	if err := context.ShouldBindJSON(&input); err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	options, err := newConversionOptions(input.ConversionOptions)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Payday moves back to the last working day unless told otherwise
+	if input.Roll == "" {
+		options.roll = holiday.Preceding
+	}
+
+	frequency := strings.ToUpper(strings.TrimSpace(input.Frequency))
+	if _, ok := payFrequencies[frequency]; !ok {
+		handleError(http.StatusBadRequest, "invalid frequency: must be *WEEKLY, *BIWEEKLY, *SEMIMONTHLY or *MONTHLY")
+		return
+	}
+
+	weekEnding := time.Saturday
+	if input.WeekEnding != "" {
+		weekday, ok := holiday.ParseWeekday(input.WeekEnding)
+		if !ok {
+			handleError(http.StatusBadRequest, "invalid week ending day: "+input.WeekEnding)
+			return
+		}
+		weekEnding = weekday
+	}
+
+	if input.PayLag < 0 || input.PayLag > maxPayLag {
+		handleError(http.StatusBadRequest, "pay lag out of range: must be between 0 and "+strconv.Itoa(maxPayLag))
+		return
+	}
+
+	date, err := resolveDate(input.Date, input.DateOptions, options)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var anchor time.Time
+	if input.Anchor != "" {
+		anchor, err = resolveDate(input.Anchor, input.DateOptions, options)
+		if err != nil {
+			handleError(http.StatusBadRequest, "anchor: "+err.Error())
+			return
+		}
+	}
+
+	period, err := calcPayPeriod(date, frequency, anchor, weekEnding)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	scheduledPayDate := period.end.AddDate(0, 0, input.PayLag)
+	payDate := options.calendar.Roll(scheduledPayDate, options.roll, options.weekend)
+	if payDate.Year() > 9999 {
+		handleError(http.StatusBadRequest, "result out of range: must be between 0001-01-01 and 9999-12-31")
+		return
+	}
+
+	output.ErrorFlag = "0"
+	output.Frequency = frequency
+	output.PayDateRolled = !payDate.Equal(scheduledPayDate)
+	output.Period = period.period
+	output.PeriodsInYear = period.periodsInYear
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"results":     output,
+		"date":        calcDatesByCalendarDate(date.Format("1/2/2006"), options),
+		"periodStart": calcDatesByCalendarDate(period.start.Format("1/2/2006"), options),
+		"periodEnd":   calcDatesByCalendarDate(period.end.Format("1/2/2006"), options),
+		"payDate":     calcDatesByCalendarDate(payDate.Format("1/2/2006"), options),
+		"weekEnding":  calcDatesByCalendarDate(nextWeekday(date, weekEnding).Format("1/2/2006"), options),
+	})
+}
+
+// The first day on or after date falling on weekday
+func nextWeekday(date time.Time, weekday time.Weekday) time.Time {
+	return date.AddDate(0, 0, (int(weekday)-int(date.Weekday())+7)%7)
+}
+
+// Periods are numbered within the calendar year they end in, so the first
+// weekly period ending in January is period 1 of that year
+func calcPayPeriod(date time.Time, frequency string, anchor time.Time, weekEnding time.Weekday) (payPeriod, error) {
+	switch frequency {
+	case "*SEMIMONTHLY":
+		start := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(date.Year(), date.Month(), 15, 0, 0, 0, 0, time.UTC)
+		period := int(date.Month())*2 - 1
+		if date.Day() > 15 {
+			start = end.AddDate(0, 0, 1)
+			end = time.Date(date.Year(), date.Month(), daysInMonth(date.Year(), date.Month()), 0, 0, 0, 0, time.UTC)
+			period++
+		}
+		return payPeriod{period: period, periodsInYear: 24, start: start, end: end}, nil
+
+	case "*MONTHLY":
+		start := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+		end := start.AddDate(0, 1, -1)
+		return payPeriod{period: int(date.Month()), periodsInYear: 12, start: start, end: end}, nil
+	}
+
+	length := payFrequencies[frequency]
+	if anchor.IsZero() {
+		if frequency == "*BIWEEKLY" {
+			return payPeriod{}, errors.New("invalid anchor: required for *BIWEEKLY")
+		}
+		anchor = nextWeekday(date, weekEnding).AddDate(0, 0, 1-length)
+	}
+
+	offset := (hundredYearDay(date) - hundredYearDay(anchor)) % length
+	if offset < 0 {
+		offset += length
+	}
+
+	start := date.AddDate(0, 0, -offset)
+	end := start.AddDate(0, 0, length-1)
+	yearEnd := time.Date(end.Year(), time.December, 31, 0, 0, 0, 0, time.UTC)
+	lastEnd := end.AddDate(0, 0, (hundredYearDay(yearEnd)-hundredYearDay(end))/length*length)
+
+	return payPeriod{
+		period:        (end.YearDay()-1)/length + 1,
+		periodsInYear: (lastEnd.YearDay()-1)/length + 1,
+		start:         start,
+		end:           end,
+	}, nil
+}
@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayPeriod(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/PayPeriod", PayPeriod)
+
+	testCases := []struct {
+		name                  string
+		payload               string
+		expectedStatus        int
+		expectedPeriod        int
+		expectedPeriodsInYear int
+		expectedRolled        bool
+		expectedDates         []string // period start, period end, pay date, week ending
+		expectedErrorText     string
+	}{
+		{
+			name:                  "Biweekly from an anchor",
+			payload:               `{"date": "2023-08-31", "anchor": "2023-01-01", "frequency": "*BIWEEKLY", "payLag": 6}`,
+			expectedStatus:        http.StatusOK,
+			expectedPeriod:        18,
+			expectedPeriodsInYear: 26,
+			expectedDates:         []string{"2023-08-27", "2023-09-09", "2023-09-15", "2023-09-02"},
+		},
+		{
+			name:                  "Weekly ending Friday without an anchor",
+			payload:               `{"date": "2023-07-01", "frequency": "*WEEKLY", "weekEnding": "FRI"}`,
+			expectedStatus:        http.StatusOK,
+			expectedPeriod:        27,
+			expectedPeriodsInYear: 52,
+			expectedDates:         []string{"2023-07-01", "2023-07-07", "2023-07-07", "2023-07-07"},
+		},
+		{
+			name:                  "Weekly in a 53 week year",
+			payload:               `{"date": "2022-12-31", "frequency": "*WEEKLY"}`,
+			expectedStatus:        http.StatusOK,
+			expectedPeriod:        53,
+			expectedPeriodsInYear: 53,
+			expectedDates:         []string{"2022-12-25", "2022-12-31", "2022-12-30", "2022-12-31"},
+			expectedRolled:        true,
+		},
+		{
+			name:                  "Semi-monthly pay date rolled before a holiday",
+			payload:               `{"date": "2023-06-20", "frequency": "*SEMIMONTHLY", "payLag": 4}`,
+			expectedStatus:        http.StatusOK,
+			expectedPeriod:        12,
+			expectedPeriodsInYear: 24,
+			expectedRolled:        true,
+			expectedDates:         []string{"2023-06-16", "2023-06-30", "2023-07-03", "2023-06-24"},
+		},
+		{
+			name:                  "Semi-monthly pay date rolled forward",
+			payload:               `{"date": "2023-06-20", "frequency": "*SEMIMONTHLY", "payLag": 4, "roll": "*FOLLOWING"}`,
+			expectedStatus:        http.StatusOK,
+			expectedPeriod:        12,
+			expectedPeriodsInYear: 24,
+			expectedRolled:        true,
+			expectedDates:         []string{"2023-06-16", "2023-06-30", "2023-07-05", "2023-06-24"},
+		},
+		{
+			name:                  "Monthly in a leap year",
+			payload:               `{"date": "2024-02-10", "frequency": "*MONTHLY"}`,
+			expectedStatus:        http.StatusOK,
+			expectedPeriod:        2,
+			expectedPeriodsInYear: 12,
+			expectedDates:         []string{"2024-02-01", "2024-02-29", "2024-02-29", "2024-02-10"},
+		},
+		{
+			name:              "Biweekly without an anchor",
+			payload:           `{"date": "2023-08-31", "frequency": "*BIWEEKLY"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid anchor: required for *BIWEEKLY",
+		},
+		{
+			name:              "Unknown frequency",
+			payload:           `{"date": "2023-08-31", "frequency": "*DAILY"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid frequency: must be *WEEKLY, *BIWEEKLY, *SEMIMONTHLY or *MONTHLY",
+		},
+		{
+			name:              "Pay lag out of range",
+			payload:           `{"date": "2023-08-31", "frequency": "*MONTHLY", "payLag": -1}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "pay lag out of range: must be between 0 and 366",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/PayPeriod", strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+
+			var responseWrapper struct {
+				Results     models.OutputPayPeriod `json:"results"`
+				PeriodStart models.OutputResults   `json:"periodStart"`
+				PeriodEnd   models.OutputResults   `json:"periodEnd"`
+				PayDate     models.OutputResults   `json:"payDate"`
+				WeekEnding  models.OutputResults   `json:"weekEnding"`
+			}
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+			assert.NoError(t, err)
+
+			results := responseWrapper.Results
+			assert.Equal(t, tc.expectedPeriod, results.Period)
+			assert.Equal(t, tc.expectedPeriodsInYear, results.PeriodsInYear)
+			assert.Equal(t, tc.expectedRolled, results.PayDateRolled)
+			assert.Equal(t, tc.expectedErrorText, results.ErrorText)
+
+			if tc.expectedDates != nil {
+				assert.Equal(t, tc.expectedDates, []string{
+					responseWrapper.PeriodStart.InternationalStandard,
+					responseWrapper.PeriodEnd.InternationalStandard,
+					responseWrapper.PayDate.InternationalStandard,
+					responseWrapper.WeekEnding.InternationalStandard,
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"date_calculation/terms"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+func PaymentTerms(context *gin.Context) {
+	var input models.InputPaymentTerms
+	var output models.OutputPaymentTerms
+
+	handleError := func(status int, errorString string) {
+		output.ErrorFlag = "HTTP " + strconv.Itoa(status)
+		output.ErrorText = errorString
+		context.JSON(status, gin.H{"results": output})
+	}
+
+	if err := context.ShouldBindJSON(&input); err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	options, err := newConversionOptions(input.ConversionOptions)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	paymentTerms, err := terms.Parse(input.Terms)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	invoiceDate, err := resolveDate(input.Date, input.DateOptions, options)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	dates := paymentTerms.Apply(invoiceDate)
+	netDate := options.calendar.Roll(dates.Net, options.roll, options.weekend)
+	discountDate := options.calendar.Roll(dates.Discount, options.roll, options.weekend)
+	if netDate.Year() > 9999 || (paymentTerms.Discount != nil && discountDate.Year() > 9999) {
+		handleError(http.StatusBadRequest, "result out of range: must be between 0001-01-01 and 9999-12-31")
+		return
+	}
+
+	output.ErrorFlag = "0"
+	output.Terms = paymentTerms.String()
+	output.NetDays = hundredYearDay(netDate) - hundredYearDay(invoiceDate)
+
+	response := gin.H{
+		"results": output,
+		"invoice": calcDatesByCalendarDate(invoiceDate.Format("1/2/2006"), options),
+		"netDue":  calcDatesByCalendarDate(netDate.Format("1/2/2006"), options),
+	}
+
+	if paymentTerms.Discount != nil {
+		output.DiscountPercent = paymentTerms.Percent
+		output.DiscountDays = hundredYearDay(discountDate) - hundredYearDay(invoiceDate)
+		response["results"] = output
+		response["discountDue"] = calcDatesByCalendarDate(discountDate.Format("1/2/2006"), options)
+	}
+
+	context.IndentedJSON(http.StatusOK, response)
+}
@@ -0,0 +1,122 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaymentTerms(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/PaymentTerms", PaymentTerms)
+
+	testCases := []struct {
+		name              string
+		payload           string
+		expectedStatus    int
+		expectedTerms     string
+		expectedPercent   float64
+		expectedDiscount  string
+		expectedNet       string
+		expectedNetDays   int
+		expectedErrorText string
+	}{
+		{
+			name:             "Discount terms",
+			payload:          `{"date": "2023-08-15", "terms": "2/10 net 30"}`,
+			expectedStatus:   http.StatusOK,
+			expectedTerms:    "2/10 NET 30",
+			expectedPercent:  2,
+			expectedDiscount: "2023-08-25",
+			expectedNet:      "2023-09-14",
+			expectedNetDays:  30,
+		},
+		{
+			name:            "End of month plus days from a HYD",
+			payload:         `{"date": "45189", "format": "*HYD", "terms": "EOM+10"}`,
+			expectedStatus:  http.StatusOK,
+			expectedTerms:   "NET EOM+10",
+			expectedNet:     "2023-10-10",
+			expectedNetDays: 19,
+		},
+		{
+			name:            "Unadjusted weekend due date",
+			payload:         `{"date": "2023-08-15", "terms": "MFI 30"}`,
+			expectedStatus:  http.StatusOK,
+			expectedTerms:   "MFI 30",
+			expectedNet:     "2023-09-30",
+			expectedNetDays: 46,
+		},
+		{
+			name:            "Weekend due date rolled",
+			payload:         `{"date": "2023-08-15", "terms": "MFI 30", "roll": "*MODFOLLOWING"}`,
+			expectedStatus:  http.StatusOK,
+			expectedTerms:   "MFI 30",
+			expectedNet:     "2023-09-29",
+			expectedNetDays: 45,
+		},
+		{
+			name:             "Discount rolled past a holiday",
+			payload:          `{"date": "2023-06-24", "terms": "1/10 NET 30", "roll": "*FOLLOWING"}`,
+			expectedStatus:   http.StatusOK,
+			expectedTerms:    "1/10 NET 30",
+			expectedPercent:  1,
+			expectedDiscount: "2023-07-05",
+			expectedNet:      "2023-07-24",
+			expectedNetDays:  30,
+		},
+		{
+			name:              "Discount after 9999",
+			payload:           `{"date": "9999-12-01", "terms": "2/40 NET EOM"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "result out of range: must be between 0001-01-01 and 9999-12-31",
+		},
+		{
+			name:              "Discount longer than net",
+			payload:           `{"date": "2023-08-15", "terms": "2/60 NET 30"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid terms: discount days must not exceed net days",
+		},
+		{
+			name:              "Unknown terms",
+			payload:           `{"date": "2023-08-15", "terms": "COD"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid terms: unrecognized net terms: COD",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/PaymentTerms", strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+
+			var responseWrapper struct {
+				Results     models.OutputPaymentTerms `json:"results"`
+				DiscountDue models.OutputResults      `json:"discountDue"`
+				NetDue      models.OutputResults      `json:"netDue"`
+			}
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+			assert.NoError(t, err)
+
+			results := responseWrapper.Results
+			assert.Equal(t, tc.expectedTerms, results.Terms)
+			assert.Equal(t, tc.expectedPercent, results.DiscountPercent)
+			assert.Equal(t, tc.expectedNetDays, results.NetDays)
+			assert.Equal(t, tc.expectedErrorText, results.ErrorText)
+			assert.Equal(t, tc.expectedDiscount, responseWrapper.DiscountDue.InternationalStandard)
+			assert.Equal(t, tc.expectedNet, responseWrapper.NetDue.InternationalStandard)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"date_calculation/rrule"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// iCalendar date-times such as 20230808T090000Z; only the date is kept
+var icalDateTimePattern = regexp.MustCompile(`^(\d{8})T\d{6}Z?$`)
+
+func Recurrence(context *gin.Context) {
+	var input models.InputRecurrence
+	var output models.OutputRecurrence
+
+	handleError := func(status int, errorString string) {
+		output.ErrorFlag = "HTTP " + strconv.Itoa(status)
+		output.ErrorText = errorString
+		context.JSON(status, gin.H{"results": output})
+	}
+
+	if err := context.ShouldBindJSON(&input); err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	options, err := newConversionOptions(input.ConversionOptions)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if input.Limit < 0 || input.Limit > rrule.MaxOccurrences {
+		handleError(http.StatusBadRequest, "invalid limit: must be between 1 and "+strconv.Itoa(rrule.MaxOccurrences))
+		return
+	}
+
+	rule, err := rrule.Parse(input.RRule)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	startDate, err := resolveDate(icalDate(input.DtStart, "DTSTART"), input.DateOptions, options)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var exdates []time.Time
+	for _, exdate := range input.ExDate {
+		excluded, err := resolveDate(icalDate(exdate, "EXDATE"), input.DateOptions, options)
+		if err != nil {
+			handleError(http.StatusBadRequest, "invalid exdate: "+err.Error())
+			return
+		}
+		exdates = append(exdates, excluded)
+	}
+
+	dates, truncated := rule.Expand(startDate, exdates, input.Limit)
+
+	occurrences := make([]models.OutputResults, len(dates))
+	for i, date := range dates {
+		occurrences[i] = calcDatesByCalendarDate(date.Format("1/2/2006"), options)
+	}
+
+	output.Count = len(occurrences)
+	output.ErrorFlag = "0"
+	output.Rule = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(input.RRule)), "RRULE:")
+	output.Truncated = truncated
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"results":     output,
+		"start":       calcDatesByCalendarDate(startDate.Format("1/2/2006"), options),
+		"occurrences": occurrences,
+	})
+}
+
+// Strips an iCalendar property name ("DTSTART;VALUE=DATE:") and the time of
+// a date-time so the date reads like any other input
+func icalDate(value string, property string) string {
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(strings.ToUpper(value), property) {
+		if _, date, ok := strings.Cut(value, ":"); ok {
+			value = date
+		}
+	}
+
+	if match := icalDateTimePattern.FindStringSubmatch(strings.ToUpper(value)); match != nil {
+		return match[1]
+	}
+
+	return value
+}
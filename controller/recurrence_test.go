@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecurrence(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/Recurrence", Recurrence)
+
+	testCases := []struct {
+		name                string
+		payload             string
+		expectedStatus      int
+		expectedCount       int
+		expectedTruncated   bool
+		expectedDates       []string
+		expectedHundredYear string
+		expectedErrorText   string
+	}{
+		{
+			name:                "Last weekday of the month with an excluded date",
+			payload:             `{"dtstart": "DTSTART;VALUE=DATE:20230801", "rrule": "RRULE:FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=4", "exdate": ["2023-09-29"]}`,
+			expectedStatus:      http.StatusOK,
+			expectedCount:       3,
+			expectedDates:       []string{"2023-08-31", "2023-10-31", "2023-11-30"},
+			expectedHundredYear: "45138",
+		},
+		{
+			name:                "Weekly from an iCalendar date-time until a date",
+			payload:             `{"dtstart": "20230808T090000Z", "rrule": "FREQ=WEEKLY;BYDAY=TU;UNTIL=20230822"}`,
+			expectedStatus:      http.StatusOK,
+			expectedCount:       3,
+			expectedDates:       []string{"2023-08-08", "2023-08-15", "2023-08-22"},
+			expectedHundredYear: "45145",
+		},
+		{
+			name:                "Open ended rule stops at the limit",
+			payload:             `{"dtstart": "45145", "format": "*HYD", "rrule": "FREQ=DAILY", "limit": 2}`,
+			expectedStatus:      http.StatusOK,
+			expectedCount:       2,
+			expectedTruncated:   true,
+			expectedDates:       []string{"2023-08-08", "2023-08-09"},
+			expectedHundredYear: "45145",
+		},
+		{
+			name:              "Limit over the cap",
+			payload:           `{"dtstart": "2023-08-08", "rrule": "FREQ=DAILY", "limit": 1001}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid limit: must be between 1 and 1000",
+		},
+		{
+			name:              "Invalid rule",
+			payload:           `{"dtstart": "2023-08-08", "rrule": "FREQ=WEEKLY;BYDAY=1MO"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid rrule: numbered BYDAY needs FREQ=MONTHLY or YEARLY",
+		},
+		{
+			name:              "Invalid excluded date",
+			payload:           `{"dtstart": "2023-08-08", "rrule": "FREQ=DAILY", "exdate": ["someday"]}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid exdate: invalid date: unrecognized format: someday",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/Recurrence", strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+
+			var responseWrapper struct {
+				Results     models.OutputRecurrence `json:"results"`
+				Start       models.OutputResults    `json:"start"`
+				Occurrences []models.OutputResults  `json:"occurrences"`
+			}
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+			assert.NoError(t, err)
+
+			results := responseWrapper.Results
+			if tc.expectedErrorText != "" {
+				assert.Equal(t, "HTTP 400", results.ErrorFlag)
+				assert.Equal(t, tc.expectedErrorText, results.ErrorText)
+				return
+			}
+
+			assert.Equal(t, "0", results.ErrorFlag)
+			assert.Equal(t, tc.expectedCount, results.Count)
+			assert.Equal(t, tc.expectedTruncated, results.Truncated)
+			assert.Equal(t, tc.expectedHundredYear, responseWrapper.Start.AcscHundredYear)
+
+			var dates []string
+			for _, occurrence := range responseWrapper.Occurrences {
+				dates = append(dates, occurrence.InternationalStandard)
+			}
+			assert.Equal(t, tc.expectedDates, dates)
+		})
+	}
+}
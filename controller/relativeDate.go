@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"date_calculation/relative"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Answers the request when its date is a relative expression such as
+// "tomorrow" or "EOM + 10". Unless required, a date that does not parse
+// as one is left for the caller to read another way and false is returned.
+func relativeCalendarDate(context *gin.Context, input models.InputCalendarDate, options conversionOptions, required bool) bool {
+	var output models.OutputResults
+
+	handleError := func(status int, errorString string) {
+		output.ErrorFlag = "HTTP " + strconv.Itoa(status)
+		output.ErrorText = errorString
+		context.JSON(status, gin.H{"results": output})
+	}
+
+	dateOptions := models.DateOptions{Order: input.Order, Locale: input.Locale, ConversionOptions: input.ConversionOptions}
+
+	anchor, _ := jobDate(input.Session)
+	if strings.TrimSpace(input.Anchor) != "" {
+		anchorDate, err := resolveDate(input.Anchor, dateOptions, options)
+		if err != nil {
+			// A bad anchor only fails a date that reads as an expression
+			if _, relativeErr := newRelativeParser(anchor, dateOptions, options).Parse(input.Date); relativeErr != nil && !required {
+				return false
+			}
+			handleError(http.StatusBadRequest, "anchor: "+err.Error())
+			return true
+		}
+		anchor = anchorDate
+	}
+
+	result, err := newRelativeParser(anchor, dateOptions, options).Parse(input.Date)
+	if err != nil {
+		if required {
+			handleError(http.StatusBadRequest, err.Error())
+		}
+		return required
+	}
+
+	output = calcDatesByCalendarDate(result.Date.Format("1/2/2006"), options)
+	context.IndentedJSON(http.StatusOK, withAdjusted(gin.H{
+		"results":        output,
+		"interpretation": result.Interpretation,
+		"anchor":         anchor.Format("2006-01-02"),
+	}, result.Date.Format("1/2/2006"), options))
+
+	return true
+}
+
+// Explicit dates and HYDs read as resolveDate reads them, and offsets are
+// held to the limits of AddDuration and AddBusinessDays
+func newRelativeParser(anchor time.Time, dateOptions models.DateOptions, options conversionOptions) relative.Parser {
+	return relative.Parser{
+		Anchor:   anchor,
+		Calendar: options.calendar,
+		Weekend:  options.weekend,
+		ReadDate: func(inputDate string) (time.Time, error) {
+			return resolveDate(inputDate, dateOptions, options)
+		},
+		ReadHundredYear: hundredYearDate,
+		Limits: relative.Limits{
+			Days:         maxDurationDays,
+			Months:       maxDurationMonths,
+			BusinessDays: maxBusinessDays,
+		},
+	}
+}
@@ -0,0 +1,158 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalcCalendarDate_Relative(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/CalcCalendarDate", CalcCalendarDate)
+
+	freezeClock(t, time.Date(2023, time.August, 31, 12, 0, 0, 0, time.UTC))
+
+	today := "2023-08-31"
+
+	testCases := []struct {
+		name                   string
+		payload                string
+		expectedStatus         int
+		expectedDate           string
+		expectedAdjusted       string
+		expectedInterpretation string
+		expectedAnchor         string
+		expectedErrorText      string
+	}{
+		{
+			name:                   "Default mode",
+			payload:                `{"date": "third Friday of next month", "anchor": "2023-08-31"}`,
+			expectedStatus:         http.StatusOK,
+			expectedDate:           "2023-09-15",
+			expectedInterpretation: "third Friday of September 2023",
+			expectedAnchor:         "2023-08-31",
+		},
+		{
+			name:              "Unreadable anchor",
+			payload:           `{"date": "EOM", "anchor": "xyz"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "anchor: invalid date: unrecognized format: xyz",
+		},
+		{
+			name:           "Unreadable anchor with a plain date",
+			payload:        `{"date": "8/31/2023", "anchor": "garbage"}`,
+			expectedStatus: http.StatusOK,
+			expectedDate:   "2023-08-31",
+		},
+		{
+			name:              "Unreadable anchor in relative mode",
+			payload:           `{"date": "banana", "mode": "relative", "anchor": "garbage"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "anchor: invalid date: unrecognized format: garbage",
+		},
+		{
+			name:                   "Auto mode falls back to expressions",
+			payload:                `{"date": "EOM", "mode": "auto", "anchor": "9/21/2023"}`,
+			expectedStatus:         http.StatusOK,
+			expectedDate:           "2023-09-30",
+			expectedInterpretation: "last day of September 2023",
+			expectedAnchor:         "2023-09-21",
+		},
+		{
+			name:                   "Relative mode",
+			payload:                `{"date": "HYD 44926 + 10", "mode": "relative"}`,
+			expectedStatus:         http.StatusOK,
+			expectedDate:           "2023-01-11",
+			expectedInterpretation: "HYD 44926 + 10 days",
+			expectedAnchor:         today,
+		},
+		{
+			name:                   "Today",
+			payload:                `{"date": "today"}`,
+			expectedStatus:         http.StatusOK,
+			expectedDate:           today,
+			expectedInterpretation: "today",
+			expectedAnchor:         today,
+		},
+		{
+			name:                   "Rolled to a business day",
+			payload:                `{"date": "EOM", "anchor": "2023-09-15", "roll": "*PRECEDING"}`,
+			expectedStatus:         http.StatusOK,
+			expectedDate:           "2023-09-30",
+			expectedAdjusted:       "2023-09-29",
+			expectedInterpretation: "last day of September 2023",
+			expectedAnchor:         "2023-09-15",
+		},
+		{
+			name:              "Relative mode rejects other dates",
+			payload:           `{"date": "banana", "mode": "relative"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid date expression: banana",
+		},
+		{
+			name:              "Offset too long",
+			payload:           `{"date": "+9223372036854775807 months", "mode": "relative"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "offset out of range: must be at most 120000 months",
+		},
+		{
+			name:              "Too many business days",
+			payload:           `{"date": "+200000 bd", "mode": "relative"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "offset out of range: must be at most 100000 business days",
+		},
+		{
+			name:              "HYD outside the range",
+			payload:           `{"date": "HYD 9223372036854775807", "mode": "relative"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "100 year date out of range: must be between -693594 and 2958464",
+		},
+		{
+			name:              "Default mode does not overflow",
+			payload:           `{"date": "+9223372036854775807 days"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid date: +9223372036854775807 days",
+		},
+		{
+			name:              "Default mode keeps its own errors",
+			payload:           `{"date": "banana"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid date: banana",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/CalcCalendarDate", strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+
+			var responseWrapper struct {
+				Results        models.OutputResults `json:"results"`
+				Adjusted       models.OutputResults `json:"adjusted"`
+				Interpretation string               `json:"interpretation"`
+				Anchor         string               `json:"anchor"`
+			}
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedDate, responseWrapper.Results.InternationalStandard)
+			assert.Equal(t, tc.expectedAdjusted, responseWrapper.Adjusted.InternationalStandard)
+			assert.Equal(t, tc.expectedInterpretation, responseWrapper.Interpretation)
+			assert.Equal(t, tc.expectedAnchor, responseWrapper.Anchor)
+			assert.Equal(t, tc.expectedErrorText, responseWrapper.Results.ErrorText)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"date_calculation/cvtdat"
+	"date_calculation/models"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Reads a date given in any supported input form
+func resolveDate(inputDate string, dateOptions models.DateOptions, options conversionOptions) (time.Time, error) {
+	inputDate = strings.TrimSpace(inputDate)
+	if inputDate == "" {
+		return time.Time{}, errors.New("invalid date: empty")
+	}
+
+	switch format := strings.ToUpper(strings.TrimSpace(dateOptions.Format)); format {
+	case "", "*AUTO":
+		order := resolveOrder(dateOptions.Order, dateOptions.Locale)
+		if order != "" && order != "MDY" && order != "DMY" && order != "YMD" {
+			return time.Time{}, errors.New("invalid order: must be MDY, DMY or YMD")
+		}
+
+		// The service's own M/D/YYYY form reads as CalcCalendarDate reads it
+		if order == "" {
+			if parsedDate, err := time.Parse("1/2/2006", inputDate); err == nil {
+				return parsedDate, nil
+			}
+		}
+
+		candidates := preferOrder(detectDate(inputDate, options.window), order)
+		if len(candidates) == 0 {
+			return time.Time{}, errors.New("invalid date: unrecognized format: " + inputDate)
+		}
+		if len(candidates) > 1 {
+			return time.Time{}, errors.New("ambiguous date: " + inputDate + " matches " + formatNames(candidates))
+		}
+
+		return candidates[0].date, nil
+	case "*HYD":
+		hundredYear, err := strconv.Atoi(inputDate)
+		if err != nil {
+			return time.Time{}, errors.New("invalid 100 year date: must be a whole number")
+		}
+
+		return hundredYearDate(hundredYear)
+	default:
+		parsedDate, err := cvtdat.ParseInWindow(inputDate, format, options.window)
+		if err != nil {
+			return time.Time{}, errors.New("invalid date: " + inputDate + ": " + err.Error())
+		}
+
+		return parsedDate, nil
+	}
+}
+
+// The date of a 100 year date within the default range
+func hundredYearDate(hundredYear int) (time.Time, error) {
+	hydRange, ok := lookupHundredYearRange("")
+	if !ok {
+		return time.Time{}, errors.New("invalid 100 year date range: must be *LEGACY or *EXTENDED")
+	}
+	if !hydRange.contains(hundredYear) {
+		return time.Time{}, errors.New("100 year date out of range: must be between " +
+			strconv.Itoa(hydRange.min) + " and " + strconv.Itoa(hydRange.max))
+	}
+
+	return hundredYearReference.AddDate(0, 0, hundredYear), nil
+}
@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"date_calculation/cvtdat"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Values our files use in date fields to mean "no date" or "forever"
+var sentinelDates = map[string]string{
+	"0001-01-01": "*LOVAL",
+	"01/01/0001": "*LOVAL",
+	"1/1/0001":   "*LOVAL",
+	"9999-12-31": "*HIVAL",
+	"12/31/9999": "*HIVAL",
+}
+
+// Host limits of the 5 digit HYD field
+const (
+	minStoredHundredYear = 0
+	maxStoredHundredYear = 99999
+)
+
+func detectSentinel(inputDate string) string {
+	inputDate = strings.TrimSpace(inputDate)
+	if sentinel, ok := sentinelDates[inputDate]; ok {
+		return sentinel
+	}
+
+	if inputDate == "" || strings.Trim(inputDate, "0123456789") != "" {
+		return ""
+	}
+
+	if strings.Trim(inputDate, "0") == "" {
+		return "*ZEROS"
+	}
+
+	// A single 9 is an ordinary HYD; a field full of them is not
+	if len(inputDate) >= 5 && strings.Trim(inputDate, "9") == "" {
+		return "*HIVAL"
+	}
+
+	return ""
+}
+
+// Reports, per output field, whether the date fits the matching host field
+func calcRepresentable(inputDate string, hundredYear string) map[string]bool {
+	parsedDate, err := time.Parse("1/2/2006", inputDate)
+	if err != nil {
+		fmt.Println("Error:", err)
+		return nil
+	}
+
+	inWindow := cvtdat.IBMWindow.Contains(parsedDate.Year())
+	inFourDigitYear := parsedDate.Year() >= 1 && parsedDate.Year() <= 9999
+
+	days, err := strconv.Atoi(hundredYear)
+	inHundredYear := err == nil && days >= minStoredHundredYear && days <= maxStoredHundredYear
+
+	return map[string]bool{
+		"AcscEuropean":          inWindow,
+		"AcscHundredYear":       inHundredYear,
+		"AcscInternational":     inWindow,
+		"AcscJulian":            inWindow,
+		"AcscUsaStandard":       inWindow,
+		"DayOfWeek":             true,
+		"EuropeanStandard":      inFourDigitYear,
+		"InternationalStandard": inFourDigitYear,
+		"UsaStandard":           inFourDigitYear,
+	}
+}
@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSentinels(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/CalcCalendarDate", CalcCalendarDate)
+	router.POST("/api/CalcHundredYearDate", CalcHundreYearDate)
+
+	testCases := []struct {
+		name             string
+		url              string
+		payload          string
+		expectedSentinel string
+		expectedHundred  string
+	}{
+		{"Low value date", "/api/CalcCalendarDate", `{"date": "0001-01-01"}`, "*LOVAL", ""},
+		{"Low value date with slashes", "/api/CalcCalendarDate", `{"date": "1/1/0001"}`, "*LOVAL", ""},
+		{"High value date", "/api/CalcCalendarDate", `{"date": "9999-12-31"}`, "*HIVAL", ""},
+		{"Zero numeric date", "/api/CalcCalendarDate", `{"date": "00000000"}`, "*ZEROS", ""},
+		{"All nines numeric date", "/api/CalcCalendarDate", `{"date": "999999"}`, "*HIVAL", ""},
+		{"Zero HYD", "/api/CalcHundredYearDate", `{"date": "0"}`, "*ZEROS", ""},
+		{"All nines HYD", "/api/CalcHundredYearDate", `{"date": "99999"}`, "*HIVAL", ""},
+		{"Zero legacy HYD", "/api/CalcHundredYearDate", `{"date": "0", "hydRange": "*LEGACY"}`, "*ZEROS", ""},
+		{"All nines legacy HYD", "/api/CalcHundredYearDate", `{"date": "99999", "hydRange": "*LEGACY"}`, "*HIVAL", ""},
+		{"Zero extended HYD", "/api/CalcHundredYearDate", `{"date": "0", "hydRange": "*EXTENDED"}`, "", "0"},
+		{"All nines extended HYD", "/api/CalcHundredYearDate", `{"date": "99999", "hydRange": "*EXTENDED"}`, "", "99999"},
+		{"Ordinary HYD of nines", "/api/CalcHundredYearDate", `{"date": "99"}`, "", "99"},
+		{"Ordinary date", "/api/CalcCalendarDate", `{"date": "1/1/2023"}`, "", "44926"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", tc.url, strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var responseWrapper ResponseWrapper
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "0", responseWrapper.Results.ErrorFlag)
+			assert.Equal(t, tc.expectedSentinel, responseWrapper.Results.Sentinel)
+			assert.Equal(t, tc.expectedHundred, responseWrapper.Results.AcscHundredYear)
+		})
+	}
+}
+
+func TestRepresentable(t *testing.T) {
+	testCases := []struct {
+		name            string
+		inputDate       string
+		hundredYear     string
+		expectedShort   bool
+		expectedHundred bool
+	}{
+		{"Inside every host field", "8/31/2023", "45168", true, true},
+		{"Before the short year window", "12/31/1939", "14610", false, true},
+		{"After the short year window", "1/1/2040", "51136", false, true},
+		{"Beyond the 5 digit HYD", "10/15/2173", "100000", false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			representable := calcRepresentable(tc.inputDate, tc.hundredYear)
+
+			assert.Equal(t, tc.expectedShort, representable["AcscUsaStandard"])
+			assert.Equal(t, tc.expectedShort, representable["AcscJulian"])
+			assert.Equal(t, tc.expectedHundred, representable["AcscHundredYear"])
+			assert.True(t, representable["InternationalStandard"])
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"date_calculation/clock"
+	"date_calculation/models"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const maxJobSessions = 10000
+
+// Job dates set per session; a session without one runs on the system date
+var jobDates = struct {
+	sync.Mutex
+	dates map[string]time.Time
+}{dates: map[string]time.Time{}}
+
+// The date a session's relative calculations start from
+func jobDate(session string) (time.Time, bool) {
+	jobDates.Lock()
+	defer jobDates.Unlock()
+
+	if date, ok := jobDates.dates[session]; ok && session != "" {
+		return date, true
+	}
+
+	return clock.Today(), false
+}
+
+func SystemDate(context *gin.Context) {
+	var input models.InputSystemDate
+	var output models.OutputSystemDate
+
+	handleError := func(status int, errorString string) {
+		output.ErrorFlag = "HTTP " + strconv.Itoa(status)
+		output.ErrorText = errorString
+		context.JSON(status, gin.H{"results": output})
+	}
+
+	if err := context.ShouldBindJSON(&input); err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	options, err := newConversionOptions(input.ConversionOptions)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	session := strings.TrimSpace(input.Session)
+	if (input.JobDate != "" || input.Reset) && session == "" {
+		handleError(http.StatusBadRequest, "invalid session: required to change the job date")
+		return
+	}
+
+	if input.JobDate != "" && input.Reset {
+		handleError(http.StatusBadRequest, "invalid request: give either jobDate or reset")
+		return
+	}
+
+	if input.JobDate != "" {
+		newDate, err := resolveDate(input.JobDate, input.DateOptions, options)
+		if err != nil {
+			handleError(http.StatusBadRequest, err.Error())
+			return
+		}
+
+		jobDates.Lock()
+		_, known := jobDates.dates[session]
+		if !known && len(jobDates.dates) >= maxJobSessions {
+			jobDates.Unlock()
+			handleError(http.StatusServiceUnavailable, "too many sessions with a job date: reset one first")
+			return
+		}
+		jobDates.dates[session] = newDate
+		jobDates.Unlock()
+	}
+
+	if input.Reset {
+		jobDates.Lock()
+		delete(jobDates.dates, session)
+		jobDates.Unlock()
+	}
+
+	now := clock.Now()
+	systemDate := clock.Today()
+	currentJobDate, changed := jobDate(session)
+
+	output.ErrorFlag = "0"
+	output.JobDate = currentJobDate.Format("01022006")
+	output.JobDateChanged = changed
+	output.Session = session
+	output.SystemDate = systemDate.Format("01022006")
+	output.SystemTime = now.Format("15:04:05")
+	output.TimeZone = clock.Location().String()
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"results": output,
+		"system":  calcDatesByCalendarDate(systemDate.Format("1/2/2006"), options),
+		"job":     calcDatesByCalendarDate(currentJobDate.Format("1/2/2006"), options),
+	})
+}
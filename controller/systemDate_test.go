@@ -0,0 +1,151 @@
+package controller
+
+import (
+	"date_calculation/clock"
+	"date_calculation/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// freezeClock pins today in UTC until the test ends
+func freezeClock(t *testing.T, at time.Time) {
+	clock.Set(clock.Frozen(at))
+	clock.SetLocation(time.UTC)
+
+	t.Cleanup(func() {
+		clock.Set(clock.System)
+		clock.SetLocation(time.Local)
+	})
+}
+
+func TestSystemDate(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	freezeClock(t, time.Date(2023, time.August, 31, 15, 4, 5, 0, time.UTC))
+
+	router := gin.Default()
+	router.POST("/api/SystemDate", SystemDate)
+	router.POST("/api/CalcCalendarDate", CalcCalendarDate)
+
+	// Run in order: later steps see the job dates earlier ones set
+	testCases := []struct {
+		name              string
+		url               string
+		payload           string
+		expectedStatus    int
+		expectedJobDate   string
+		expectedChanged   bool
+		expectedDate      string
+		expectedErrorText string
+	}{
+		{
+			name:            "System date without a session",
+			url:             "/api/SystemDate",
+			payload:         `{}`,
+			expectedStatus:  http.StatusOK,
+			expectedJobDate: "08312023",
+			expectedDate:    "2023-08-31",
+		},
+		{
+			name:            "Change the job date",
+			url:             "/api/SystemDate",
+			payload:         `{"session": "QPADEV0001", "jobDate": "011523", "format": "*MDY"}`,
+			expectedStatus:  http.StatusOK,
+			expectedJobDate: "01152023",
+			expectedChanged: true,
+			expectedDate:    "2023-01-15",
+		},
+		{
+			name:            "Job date kept for the session",
+			url:             "/api/SystemDate",
+			payload:         `{"session": "QPADEV0001"}`,
+			expectedStatus:  http.StatusOK,
+			expectedJobDate: "01152023",
+			expectedChanged: true,
+			expectedDate:    "2023-01-15",
+		},
+		{
+			name:            "Other sessions keep the system date",
+			url:             "/api/SystemDate",
+			payload:         `{"session": "QPADEV0002"}`,
+			expectedStatus:  http.StatusOK,
+			expectedJobDate: "08312023",
+			expectedDate:    "2023-08-31",
+		},
+		{
+			name:           "Relative dates count from the job date",
+			url:            "/api/CalcCalendarDate",
+			payload:        `{"date": "tomorrow", "session": "QPADEV0001"}`,
+			expectedStatus: http.StatusOK,
+			expectedDate:   "2023-01-16",
+		},
+		{
+			name:            "Reset the job date",
+			url:             "/api/SystemDate",
+			payload:         `{"session": "QPADEV0001", "reset": true}`,
+			expectedStatus:  http.StatusOK,
+			expectedJobDate: "08312023",
+			expectedDate:    "2023-08-31",
+		},
+		{
+			name:           "Relative dates follow the frozen clock",
+			url:            "/api/CalcCalendarDate",
+			payload:        `{"date": "tomorrow", "session": "QPADEV0001"}`,
+			expectedStatus: http.StatusOK,
+			expectedDate:   "2023-09-01",
+		},
+		{
+			name:              "Changing the job date needs a session",
+			url:               "/api/SystemDate",
+			payload:           `{"jobDate": "2023-01-15"}`,
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorText: "invalid session: required to change the job date",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", tc.url, strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+
+			if tc.url == "/api/CalcCalendarDate" {
+				var responseWrapper ResponseWrapper
+				err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedDate, responseWrapper.Results.InternationalStandard)
+				return
+			}
+
+			var responseWrapper struct {
+				Results models.OutputSystemDate `json:"results"`
+				System  models.OutputResults    `json:"system"`
+				Job     models.OutputResults    `json:"job"`
+			}
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+			assert.NoError(t, err)
+
+			results := responseWrapper.Results
+			assert.Equal(t, tc.expectedErrorText, results.ErrorText)
+			assert.Equal(t, tc.expectedJobDate, results.JobDate)
+			assert.Equal(t, tc.expectedChanged, results.JobDateChanged)
+			assert.Equal(t, tc.expectedDate, responseWrapper.Job.InternationalStandard)
+			if tc.expectedStatus == http.StatusOK {
+				assert.Equal(t, "08312023", results.SystemDate)
+				assert.Equal(t, "15:04:05", results.SystemTime)
+				assert.Equal(t, "UTC", results.TimeZone)
+				assert.Equal(t, "2023-08-31", responseWrapper.System.InternationalStandard)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"date_calculation/holiday"
+	"date_calculation/models"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func YearInfo(context *gin.Context) {
+	var input models.InputYearInfo
+	var output models.OutputYearInfo
+
+	handleError := func(status int, errorString string) {
+		output.ErrorFlag = "HTTP " + strconv.Itoa(status)
+		output.ErrorText = errorString
+		context.JSON(status, gin.H{"results": output})
+	}
+
+	if err := context.ShouldBindJSON(&input); err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	options, err := newConversionOptions(input.ConversionOptions)
+	if err != nil {
+		handleError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if input.Year < 1 || input.Year > 9999 {
+		handleError(http.StatusBadRequest, "invalid year: must be between 1 and 9999")
+		return
+	}
+
+	output = calcYearInfo(input.Year, options)
+
+	firstDay := time.Date(input.Year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	lastDay := time.Date(input.Year, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"results": output,
+		"first":   calcDatesByCalendarDate(firstDay.Format("1/2/2006"), options),
+		"last":    calcDatesByCalendarDate(lastDay.Format("1/2/2006"), options),
+	})
+}
+
+func calcYearInfo(year int, options conversionOptions) models.OutputYearInfo {
+	var output models.OutputYearInfo
+
+	firstDay := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	days := daysInYear(year)
+	easter := holiday.Easter(year)
+
+	output.Calendar = options.calendar.Name
+	output.Days = days
+	output.EasterDate = easter.Format("2006-01-02")
+	output.EasterHundredYear = strconv.Itoa(hundredYearDay(easter))
+	output.FirstDayName = firstDay.Weekday().String()
+	output.FirstDayOfWeek = weekdayAbbreviations[firstDay.Weekday()]
+	output.FirstHundredYear = strconv.Itoa(hundredYearDay(firstDay))
+	output.FirstJulian = fmt.Sprintf("%02d-%03d", year%100, 1)
+	output.FirstLongJulian = fmt.Sprintf("%04d%03d", year, 1)
+	output.Holidays = yearHolidays(options.calendar.Holidays(year))
+	output.IsoWeeks = isoWeeksInYear(year)
+	output.LastHundredYear = strconv.Itoa(hundredYearDay(firstDay) + days - 1)
+	output.LastJulian = fmt.Sprintf("%02d-%03d", year%100, days)
+	output.LastLongJulian = fmt.Sprintf("%04d%03d", year, days)
+	output.LeapYear = isLeapYear(year)
+	output.Year = year
+	output.ErrorFlag = "0"
+
+	return output
+}
+
+func yearHolidays(holidays []holiday.Holiday) []models.YearHoliday {
+	output := make([]models.YearHoliday, len(holidays))
+	for i, h := range holidays {
+		name := h.Name
+		if h.IsObserved() {
+			name += " (observed)"
+		}
+
+		output[i] = models.YearHoliday{
+			Actual:      h.Actual.Format("2006-01-02"),
+			Date:        h.Date.Format("2006-01-02"),
+			DayOfWeek:   weekdayAbbreviations[h.Date.Weekday()],
+			HundredYear: strconv.Itoa(hundredYearDay(h.Date)),
+			Name:        name,
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,106 @@
+package controller
+
+import (
+	"date_calculation/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestYearInfo(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.Default()
+	router.POST("/api/YearInfo", YearInfo)
+
+	testCases := []struct {
+		name              string
+		payload           string
+		expectedStatus    int
+		expectedValues    models.OutputYearInfo
+		expectedHolidays  int
+		expectedFirstDate string
+		expectedLastDate  string
+	}{
+		{
+			name:           "Common year",
+			payload:        `{"year": 2023}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputYearInfo{
+				Calendar: "US", Days: 365, EasterDate: "2023-04-09", EasterHundredYear: "45024", ErrorFlag: "0",
+				FirstDayName: "Sunday", FirstDayOfWeek: "SUN.", FirstHundredYear: "44926",
+				FirstJulian: "23-001", FirstLongJulian: "2023001", IsoWeeks: 52,
+				LastHundredYear: "45290", LastJulian: "23-365", LastLongJulian: "2023365", Year: 2023,
+			},
+			expectedHolidays:  11,
+			expectedFirstDate: "2023-01-01",
+			expectedLastDate:  "2023-12-31",
+		},
+		{
+			name:           "Leap year with 53 ISO weeks",
+			payload:        `{"year": 2020, "calendar": "UK"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: models.OutputYearInfo{
+				Calendar: "UK", Days: 366, EasterDate: "2020-04-12", EasterHundredYear: "43932", ErrorFlag: "0",
+				FirstDayName: "Wednesday", FirstDayOfWeek: "WED.", FirstHundredYear: "43830",
+				FirstJulian: "20-001", FirstLongJulian: "2020001", IsoWeeks: 53,
+				LastHundredYear: "44195", LastJulian: "20-366", LastLongJulian: "2020366", LeapYear: true, Year: 2020,
+			},
+			expectedHolidays:  8,
+			expectedFirstDate: "2020-01-01",
+			expectedLastDate:  "2020-12-31",
+		},
+		{
+			name:           "Year out of range",
+			payload:        `{"year": 0}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedValues: models.OutputYearInfo{ErrorFlag: "HTTP 400", ErrorText: "invalid year: must be between 1 and 9999"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/YearInfo", strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+
+			var responseWrapper struct {
+				Results models.OutputYearInfo `json:"results"`
+				First   models.OutputResults  `json:"first"`
+				Last    models.OutputResults  `json:"last"`
+			}
+			err := json.NewDecoder(w.Body).Decode(&responseWrapper)
+			assert.NoError(t, err)
+
+			results := responseWrapper.Results
+			assert.Len(t, results.Holidays, tc.expectedHolidays)
+			results.Holidays = nil
+			assert.Equal(t, tc.expectedValues, results)
+			assert.Equal(t, tc.expectedFirstDate, responseWrapper.First.InternationalStandard)
+			assert.Equal(t, tc.expectedLastDate, responseWrapper.Last.InternationalStandard)
+		})
+	}
+}
+
+func TestYearInfoHolidays(t *testing.T) {
+	info := calcYearInfo(2021, defaultOptions)
+
+	assert.Len(t, info.Holidays, 12)
+	assert.Equal(t, models.YearHoliday{
+		Actual:      "2021-07-04",
+		Date:        "2021-07-05",
+		DayOfWeek:   "MON.",
+		HundredYear: "44381",
+		Name:        "Independence Day (observed)",
+	}, info.Holidays[5])
+	assert.Equal(t, "New Year's Day (observed)", info.Holidays[11].Name)
+	assert.Equal(t, "2022-01-01", info.Holidays[11].Actual)
+}
@@ -0,0 +1,396 @@
+// Package cvtdat emulates the IBM i Convert Date (CVTDAT) CL command.
+package cvtdat
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Date formats accepted by the FROMFMT and TOFMT parameters
+const (
+	JOB     = "*JOB"
+	SYSVAL  = "*SYSVAL"
+	MDY     = "*MDY"
+	DMY     = "*DMY"
+	YMD     = "*YMD"
+	JUL     = "*JUL"
+	MDYY    = "*MDYY"
+	DMYY    = "*DMYY"
+	YYMD    = "*YYMD"
+	CYMD    = "*CYMD"
+	CMDY    = "*CMDY"
+	CDMY    = "*CDMY"
+	LONGJUL = "*LONGJUL"
+	ISO     = "*ISO"
+	USA     = "*USA"
+	EUR     = "*EUR"
+	JIS     = "*JIS"
+)
+
+// JobFormat is the format *JOB and *SYSVAL resolve to
+var JobFormat = MDY
+
+// JobSeparator is the separator TOSEP(*JOB) and TOSEP(*SYSVAL) resolve to
+var JobSeparator = "/"
+
+// Escape messages sent by CVTDAT
+const (
+	ErrTooShort       = "CPF0550"
+	ErrSeparator      = "CPF0551"
+	ErrExtraSeparator = "CPF0552"
+	ErrTooLong        = "CPF0553"
+	ErrNotValid       = "CPF0555"
+	ErrFormat         = "CPF0556"
+	ErrRange          = "CPF0557"
+)
+
+var messageText = map[string]string{
+	ErrTooShort:       "Date too short for specified format.",
+	ErrSeparator:      "Separators in date are not valid.",
+	ErrExtraSeparator: "Date contains misplaced or extra separators.",
+	ErrTooLong:        "Date too long for specified format.",
+	ErrNotValid:       "Date not in specified format or date not valid.",
+	ErrFormat:         "Date format not valid.",
+	ErrRange:          "Date outside the range allowed for the specified format.",
+}
+
+// Error carries the CPF message identifier CVTDAT would have signalled
+type Error struct {
+	ID   string
+	Text string
+}
+
+func (e *Error) Error() string {
+	return e.ID + ": " + e.Text
+}
+
+func newError(id string) *Error {
+	return &Error{ID: id, Text: messageText[id]}
+}
+
+type component int
+
+const (
+	century component = iota
+	shortYear
+	longYear
+	month
+	day
+	dayOfYear
+)
+
+var componentWidth = map[component]int{
+	century:   1,
+	shortYear: 2,
+	longYear:  4,
+	month:     2,
+	day:       2,
+	dayOfYear: 3,
+}
+
+// A format is a list of groups written with a separator between each group
+type format struct {
+	groups   [][]component
+	fixedSep string
+}
+
+var formats = map[string]format{
+	MDY:     {groups: [][]component{{month}, {day}, {shortYear}}},
+	DMY:     {groups: [][]component{{day}, {month}, {shortYear}}},
+	YMD:     {groups: [][]component{{shortYear}, {month}, {day}}},
+	JUL:     {groups: [][]component{{shortYear}, {dayOfYear}}},
+	MDYY:    {groups: [][]component{{month}, {day}, {longYear}}},
+	DMYY:    {groups: [][]component{{day}, {month}, {longYear}}},
+	YYMD:    {groups: [][]component{{longYear}, {month}, {day}}},
+	CYMD:    {groups: [][]component{{century, shortYear}, {month}, {day}}},
+	CMDY:    {groups: [][]component{{century, month}, {day}, {shortYear}}},
+	CDMY:    {groups: [][]component{{century, day}, {month}, {shortYear}}},
+	LONGJUL: {groups: [][]component{{longYear}, {dayOfYear}}},
+	ISO:     {groups: [][]component{{longYear}, {month}, {day}}, fixedSep: "-"},
+	USA:     {groups: [][]component{{month}, {day}, {longYear}}, fixedSep: "/"},
+	EUR:     {groups: [][]component{{day}, {month}, {longYear}}, fixedSep: "."},
+	JIS:     {groups: [][]component{{longYear}, {month}, {day}}, fixedSep: "-"},
+}
+
+var separators = map[string]string{
+	"*SLASH":  "/",
+	"*DASH":   "-",
+	"*PERIOD": ".",
+	"*COMMA":  ",",
+	"*BLANK":  " ",
+	"*NONE":   "",
+	"/":       "/",
+	"-":       "-",
+	".":       ".",
+	",":       ",",
+	" ":       " ",
+}
+
+func lookupFormat(name string) (format, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if name == "" || name == JOB || name == SYSVAL {
+		name = JobFormat
+	}
+
+	f, ok := formats[name]
+	if !ok {
+		return format{}, newError(ErrFormat)
+	}
+
+	return f, nil
+}
+
+func lookupSeparator(name string) (string, error) {
+	if strings.TrimSpace(name) == "" && name != " " {
+		return JobSeparator, nil
+	}
+
+	upper := strings.ToUpper(name)
+	if upper == JOB || upper == SYSVAL {
+		return JobSeparator, nil
+	}
+
+	sep, ok := separators[upper]
+	if !ok {
+		return "", newError(ErrSeparator)
+	}
+
+	return sep, nil
+}
+
+func isSeparator(r rune) bool {
+	return r == '/' || r == '-' || r == '.' || r == ',' || r == ' '
+}
+
+func (f format) width() int {
+	width := 0
+	for _, group := range f.groups {
+		for _, c := range group {
+			width += componentWidth[c]
+		}
+	}
+
+	return width
+}
+
+func (f format) hasCentury() bool {
+	for _, group := range f.groups {
+		for _, c := range group {
+			if c == century {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Parse reads a date written in one of the CVTDAT formats. As with CVTDAT,
+// the date may be written with or without separators; leading zeros may be
+// dropped from single-component groups only when separators are used.
+func Parse(date string, fromFmt string) (time.Time, error) {
+	return ParseInWindow(date, fromFmt, IBMWindow)
+}
+
+// ParseInWindow is Parse with two digit years resolved into the given window
+func ParseInWindow(date string, fromFmt string, window Window) (time.Time, error) {
+	f, err := lookupFormat(fromFmt)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	date = strings.TrimSpace(date)
+	if date == "" {
+		return time.Time{}, newError(ErrTooShort)
+	}
+
+	sep := ""
+	for _, r := range date {
+		if r >= '0' && r <= '9' {
+			continue
+		}
+		if !isSeparator(r) {
+			return time.Time{}, newError(ErrNotValid)
+		}
+		if sep == "" {
+			sep = string(r)
+		} else if sep != string(r) {
+			return time.Time{}, newError(ErrExtraSeparator)
+		}
+	}
+
+	if sep != "" && f.fixedSep != "" && sep != f.fixedSep {
+		return time.Time{}, newError(ErrSeparator)
+	}
+
+	values := map[component]int{}
+	if sep == "" {
+		if len(date) < f.width() {
+			return time.Time{}, newError(ErrTooShort)
+		}
+		if len(date) > f.width() {
+			return time.Time{}, newError(ErrTooLong)
+		}
+
+		pos := 0
+		for _, group := range f.groups {
+			for _, c := range group {
+				values[c], _ = strconv.Atoi(date[pos : pos+componentWidth[c]])
+				pos += componentWidth[c]
+			}
+		}
+	} else {
+		parts := strings.Split(date, sep)
+		if len(parts) != len(f.groups) {
+			return time.Time{}, newError(ErrExtraSeparator)
+		}
+
+		for i, group := range f.groups {
+			if err := readGroup(parts[i], group, values); err != nil {
+				return time.Time{}, err
+			}
+		}
+	}
+
+	return buildDate(values, window)
+}
+
+func readGroup(part string, group []component, values map[component]int) error {
+	if part == "" {
+		return newError(ErrExtraSeparator)
+	}
+
+	if len(group) == 1 {
+		c := group[0]
+		if len(part) > componentWidth[c] {
+			return newError(ErrTooLong)
+		}
+		if c == longYear && len(part) < componentWidth[c] {
+			return newError(ErrTooShort)
+		}
+		values[c], _ = strconv.Atoi(part)
+		return nil
+	}
+
+	width := 0
+	for _, c := range group {
+		width += componentWidth[c]
+	}
+	if len(part) < width {
+		return newError(ErrTooShort)
+	}
+	if len(part) > width {
+		return newError(ErrTooLong)
+	}
+
+	pos := 0
+	for _, c := range group {
+		values[c], _ = strconv.Atoi(part[pos : pos+componentWidth[c]])
+		pos += componentWidth[c]
+	}
+
+	return nil
+}
+
+func buildDate(values map[component]int, window Window) (time.Time, error) {
+	year, hasLongYear := values[longYear]
+	if !hasLongYear {
+		yy := values[shortYear]
+		if c, hasCentury := values[century]; hasCentury {
+			year = 1900 + c*100 + yy
+		} else {
+			year = window.Expand(yy)
+		}
+	}
+
+	if year < 1 || year > 9999 {
+		return time.Time{}, newError(ErrRange)
+	}
+
+	if doy, isJulian := values[dayOfYear]; isJulian {
+		if doy < 1 || doy > time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC).YearDay() {
+			return time.Time{}, newError(ErrNotValid)
+		}
+		return time.Date(year, 1, doy, 0, 0, 0, 0, time.UTC), nil
+	}
+
+	m, d := values[month], values[day]
+	date := time.Date(year, time.Month(m), d, 0, 0, 0, 0, time.UTC)
+	if m < 1 || m > 12 || d < 1 || date.Day() != d {
+		return time.Time{}, newError(ErrNotValid)
+	}
+
+	return date, nil
+}
+
+// Format writes a date in one of the CVTDAT formats. The separator is
+// ignored for *ISO, *USA, *EUR and *JIS, which always use their own.
+func Format(date time.Time, toFmt string, toSep string) (string, error) {
+	return FormatInWindow(date, toFmt, toSep, IBMWindow)
+}
+
+// FormatInWindow is Format with two digit years limited to the given window
+func FormatInWindow(date time.Time, toFmt string, toSep string, window Window) (string, error) {
+	f, err := lookupFormat(toFmt)
+	if err != nil {
+		return "", err
+	}
+
+	sep := f.fixedSep
+	if sep == "" {
+		if sep, err = lookupSeparator(toSep); err != nil {
+			return "", err
+		}
+	}
+
+	year := date.Year()
+	groups := make([]string, 0, len(f.groups))
+	for _, group := range f.groups {
+		var sb strings.Builder
+		for _, c := range group {
+			switch c {
+			case century:
+				if year < 1900 || year > 2899 {
+					return "", newError(ErrRange)
+				}
+				sb.WriteString(strconv.Itoa((year - 1900) / 100))
+			case shortYear:
+				if !f.hasCentury() && !window.Contains(year) {
+					return "", newError(ErrRange)
+				}
+				fmt.Fprintf(&sb, "%02d", year%100)
+			case longYear:
+				if year < 1 || year > 9999 {
+					return "", newError(ErrRange)
+				}
+				fmt.Fprintf(&sb, "%04d", year)
+			case month:
+				fmt.Fprintf(&sb, "%02d", int(date.Month()))
+			case day:
+				fmt.Fprintf(&sb, "%02d", date.Day())
+			case dayOfYear:
+				fmt.Fprintf(&sb, "%03d", date.YearDay())
+			}
+		}
+		groups = append(groups, sb.String())
+	}
+
+	return strings.Join(groups, sep), nil
+}
+
+// Convert mirrors CVTDAT DATE(date) FROMFMT(fromFmt) TOFMT(toFmt) TOSEP(toSep)
+func Convert(date string, fromFmt string, toFmt string, toSep string) (string, error) {
+	return ConvertInWindow(date, fromFmt, toFmt, toSep, IBMWindow)
+}
+
+// ConvertInWindow is Convert with two digit years read and written in the given window
+func ConvertInWindow(date string, fromFmt string, toFmt string, toSep string, window Window) (string, error) {
+	parsedDate, err := ParseInWindow(date, fromFmt, window)
+	if err != nil {
+		return "", err
+	}
+
+	return FormatInWindow(parsedDate, toFmt, toSep, window)
+}
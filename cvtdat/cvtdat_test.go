@@ -0,0 +1,109 @@
+package cvtdat
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvert(t *testing.T) {
+	testCases := []struct {
+		name     string
+		date     string
+		fromFmt  string
+		toFmt    string
+		toSep    string
+		expected string
+	}{
+		{"MDY to ISO", "083123", MDY, ISO, "", "2023-08-31"},
+		{"MDY with separators to YMD", "8/31/23", MDY, YMD, "*DASH", "23-08-31"},
+		{"Window low end", "010140", MDY, YYMD, "*NONE", "19400101"},
+		{"Window high end", "123139", MDY, YYMD, "*NONE", "20391231"},
+		{"JUL to MDY", "23-243", JUL, MDY, "*SLASH", "08/31/23"},
+		{"LONGJUL to EUR", "2024366", LONGJUL, EUR, "", "31.12.2024"},
+		{"CYMD to USA", "1230831", CYMD, USA, "", "08/31/2023"},
+		{"CYMD with separators", "123/08/31", CYMD, ISO, "", "2023-08-31"},
+		{"CYMD nineteenth century digit", "0991231", CYMD, ISO, "", "1999-12-31"},
+		{"ISO to CYMD", "2023-08-31", ISO, CYMD, "*NONE", "1230831"},
+		{"ISO to CMDY", "2023-08-31", ISO, CMDY, "/", "108/31/23"},
+		{"ISO to CDMY", "2023-08-31", ISO, CDMY, ".", "131.08.23"},
+		{"USA to DMYY", "08/31/2023", USA, DMYY, "*BLANK", "31 08 2023"},
+		{"JIS to LONGJUL", "2023-08-31", JIS, LONGJUL, "*COMMA", "2023,243"},
+		{"ISO without separators", "20230831", ISO, MDYY, "*NONE", "08312023"},
+		{"Job format default", "083123", "*JOB", "*ISO", "*JOB", "2023-08-31"},
+		{"Lowercase format names", "083123", "*mdy", "*iso", "", "2023-08-31"},
+		{"Four digit year formats outside window", "01/01/1899", MDYY, ISO, "", "1899-01-01"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			converted, err := Convert(tc.date, tc.fromFmt, tc.toFmt, tc.toSep)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, converted)
+		})
+	}
+}
+
+func TestConvert_Errors(t *testing.T) {
+	testCases := []struct {
+		name       string
+		date       string
+		fromFmt    string
+		toFmt      string
+		expectedID string
+	}{
+		{"Too short", "08312", MDY, ISO, ErrTooShort},
+		{"Too long", "0831234", MDY, ISO, ErrTooLong},
+		{"Mixed separators", "08/31-23", MDY, ISO, ErrExtraSeparator},
+		{"Extra separator", "08/31/23/1", MDY, ISO, ErrExtraSeparator},
+		{"Wrong separator for ISO", "2023/08/31", ISO, MDY, ErrSeparator},
+		{"Non numeric", "08/3x/23", MDY, ISO, ErrNotValid},
+		{"Invalid day", "023023", MDY, ISO, ErrNotValid},
+		{"Julian day 366 in common year", "23366", JUL, ISO, ErrNotValid},
+		{"Two digit year out of window", "1939-12-31", ISO, MDY, ErrRange},
+		{"Century digit out of range", "1899-12-31", ISO, CYMD, ErrRange},
+		{"Unknown format", "083123", "*XYZ", ISO, ErrFormat},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Convert(tc.date, tc.fromFmt, tc.toFmt, "")
+
+			var cpfError *Error
+			assert.True(t, errors.As(err, &cpfError))
+			assert.Equal(t, tc.expectedID, cpfError.ID)
+		})
+	}
+}
+
+func TestConvertInWindow(t *testing.T) {
+	testCases := []struct {
+		name     string
+		date     string
+		fromFmt  string
+		toFmt    string
+		window   Window
+		expected string
+	}{
+		{"Fixed window reads into the next century", "010145", MDY, ISO, FixedWindow(1950), "2045-01-01"},
+		{"Fixed window reads the start year", "010150", MDY, ISO, FixedWindow(1950), "1950-01-01"},
+		{"Fixed window writes outside the IBM window", "2045-01-01", ISO, MDY, FixedWindow(1950), "01/01/45"},
+		{"Window not aligned to a century", "23001", JUL, ISO, FixedWindow(2024), "2123-01-01"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			converted, err := ConvertInWindow(tc.date, tc.fromFmt, tc.toFmt, "/", tc.window)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, converted)
+		})
+	}
+
+	_, err := ConvertInWindow("1949-12-31", ISO, MDY, "/", FixedWindow(1950))
+	var cpfError *Error
+	assert.True(t, errors.As(err, &cpfError))
+	assert.Equal(t, ErrRange, cpfError.ID)
+}
@@ -0,0 +1,46 @@
+package cvtdat
+
+import (
+	"strconv"
+	"time"
+)
+
+// Window is the span of 100 years a two digit year is resolved into
+type Window struct {
+	Start int
+}
+
+// IBMWindow is the 1940-2039 window used by *MDY, *DMY, *YMD and *JUL
+var IBMWindow = Window{Start: 1940}
+
+// FixedWindow starts the window at a fixed year, e.g. 1950 for 1950-2049
+func FixedWindow(start int) Window {
+	return Window{Start: start}
+}
+
+// SlidingWindow starts the window a number of years before the given date
+func SlidingWindow(today time.Time, yearsBack int) Window {
+	return Window{Start: today.Year() - yearsBack}
+}
+
+func (w Window) End() int {
+	return w.Start + 99
+}
+
+func (w Window) Contains(year int) bool {
+	return year >= w.Start && year <= w.End()
+}
+
+// Expand resolves a two digit year to the only year in the window ending in it
+func (w Window) Expand(yy int) int {
+	year := w.Start - w.Start%100 + yy
+	if year < w.Start {
+		year += 100
+	}
+
+	return year
+}
+
+func (w Window) String() string {
+	return strconv.Itoa(w.Start) + "-" + strconv.Itoa(w.End())
+}
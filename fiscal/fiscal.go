@@ -0,0 +1,188 @@
+// Package fiscal maps dates onto fiscal years made of calendar months or of
+// 4-4-5 style week patterns in 52/53-week years.
+package fiscal
+
+import (
+	"errors"
+	"time"
+)
+
+// Rules for the last day of a 52/53-week year
+const (
+	// Last ends the year on the last EndWeekday of the month before StartMonth
+	Last = "*LAST"
+	// Nearest ends the year on the EndWeekday nearest the end of that month
+	Nearest = "*NEAREST"
+)
+
+// Year labels
+const (
+	// LabelEnd names a fiscal year after the calendar year it ends in
+	LabelEnd = "*END"
+	// LabelStart names a fiscal year after the calendar year it starts in
+	LabelStart = "*START"
+)
+
+var patterns = map[string][3]int{
+	"445": {4, 4, 5},
+	"454": {4, 5, 4},
+	"544": {5, 4, 4},
+}
+
+// Calendar describes a fiscal year. Without a Pattern the fiscal year is made
+// of calendar months starting on the first of StartMonth; with one it is
+// made of whole weeks, ends by EndRule and puts a 53rd week in period 12.
+type Calendar struct {
+	StartMonth time.Month
+	Pattern    string
+	EndWeekday time.Weekday
+	EndRule    string
+	YearLabel  string
+}
+
+// NRF is the National Retail Federation 4-5-4 calendar, whose year ends on
+// the Saturday nearest January 31 and is named after the year it starts in
+var NRF = Calendar{
+	StartMonth: time.February,
+	Pattern:    "454",
+	EndWeekday: time.Saturday,
+	EndRule:    Nearest,
+	YearLabel:  LabelStart,
+}
+
+// Period places a date within its fiscal year
+type Period struct {
+	Year        int
+	Quarter     int
+	Period      int
+	Week        int
+	WeeksInYear int
+	YearStart   time.Time
+	YearEnd     time.Time
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+}
+
+// Validate reports the first setting Locate cannot work with
+func (c Calendar) Validate() error {
+	if c.StartMonth < time.January || c.StartMonth > time.December {
+		return errors.New("invalid fiscal start month: must be between 1 and 12")
+	}
+
+	if c.Pattern != "" {
+		if _, ok := patterns[c.Pattern]; !ok {
+			return errors.New("invalid fiscal pattern: must be 445, 454 or 544")
+		}
+		if c.EndRule != Last && c.EndRule != Nearest {
+			return errors.New("invalid fiscal end rule: must be *LAST or *NEAREST")
+		}
+	}
+
+	if c.YearLabel != LabelEnd && c.YearLabel != LabelStart {
+		return errors.New("invalid fiscal year label: must be *END or *START")
+	}
+
+	return nil
+}
+
+func days(from time.Time, to time.Time) int {
+	return int((to.Unix() - from.Unix()) / (24 * 60 * 60))
+}
+
+// The fiscal year ending in a calendar year is named after the year it
+// started in only when it did not start in January
+func (c Calendar) label(endYear int) int {
+	if c.YearLabel == LabelStart && c.StartMonth != time.January {
+		return endYear - 1
+	}
+
+	return endYear
+}
+
+// Locate finds the fiscal period a date falls in
+func (c Calendar) Locate(date time.Time) Period {
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	if c.Pattern == "" {
+		return c.locateMonthly(date)
+	}
+
+	return c.locateWeekly(date)
+}
+
+func (c Calendar) locateMonthly(date time.Time) Period {
+	startYear := date.Year()
+	if date.Month() < c.StartMonth {
+		startYear--
+	}
+
+	yearStart := time.Date(startYear, c.StartMonth, 1, 0, 0, 0, 0, time.UTC)
+	yearEnd := yearStart.AddDate(1, 0, -1)
+	months := (date.Year()-startYear)*12 + int(date.Month()-c.StartMonth)
+	periodStart := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	return Period{
+		Year:        c.label(yearEnd.Year()),
+		Quarter:     months/3 + 1,
+		Period:      months + 1,
+		Week:        days(yearStart, date)/7 + 1,
+		WeeksInYear: (days(yearStart, yearEnd) + 7) / 7,
+		YearStart:   yearStart,
+		YearEnd:     yearEnd,
+		PeriodStart: periodStart,
+		PeriodEnd:   periodStart.AddDate(0, 1, -1),
+	}
+}
+
+// The last day of the fiscal year whose final month falls in a calendar year
+func (c Calendar) yearEnd(year int) time.Time {
+	endMonth := c.StartMonth - 1
+	if endMonth == 0 {
+		endMonth = time.December
+	}
+
+	monthEnd := time.Date(year, endMonth+1, 0, 0, 0, 0, 0, time.UTC)
+	back := (int(monthEnd.Weekday()) - int(c.EndWeekday) + 7) % 7
+	if c.EndRule == Nearest && back > 3 {
+		return monthEnd.AddDate(0, 0, 7-back)
+	}
+
+	return monthEnd.AddDate(0, 0, -back)
+}
+
+func (c Calendar) locateWeekly(date time.Time) Period {
+	endYear := date.Year() - 1
+	for !date.After(c.yearEnd(endYear-1)) || date.After(c.yearEnd(endYear)) {
+		endYear++
+	}
+
+	yearStart := c.yearEnd(endYear-1).AddDate(0, 0, 1)
+	yearEnd := c.yearEnd(endYear)
+	weeksInYear := (days(yearStart, yearEnd) + 1) / 7
+	week := days(yearStart, date)/7 + 1
+
+	pattern := patterns[c.Pattern]
+	period, firstWeek, weeks := 1, 1, pattern[0]
+	for week >= firstWeek+weeks {
+		firstWeek += weeks
+		period++
+		weeks = pattern[(period-1)%3]
+		if period == 12 {
+			weeks += weeksInYear - 52
+		}
+	}
+
+	periodStart := yearStart.AddDate(0, 0, (firstWeek-1)*7)
+
+	return Period{
+		Year:        c.label(endYear),
+		Quarter:     (period-1)/3 + 1,
+		Period:      period,
+		Week:        week,
+		WeeksInYear: weeksInYear,
+		YearStart:   yearStart,
+		YearEnd:     yearEnd,
+		PeriodStart: periodStart,
+		PeriodEnd:   periodStart.AddDate(0, 0, weeks*7-1),
+	}
+}
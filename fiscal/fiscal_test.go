@@ -0,0 +1,108 @@
+package fiscal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestLocate(t *testing.T) {
+	october := Calendar{StartMonth: time.October, YearLabel: LabelEnd}
+	calendar445 := Calendar{StartMonth: time.January, Pattern: "445", EndWeekday: time.Saturday, EndRule: Last, YearLabel: LabelEnd}
+
+	testCases := []struct {
+		name     string
+		calendar Calendar
+		date     time.Time
+		expected Period
+	}{
+		{
+			name:     "Monthly year starting in October",
+			calendar: october,
+			date:     date(2023, time.August, 31),
+			expected: Period{
+				Year: 2023, Quarter: 4, Period: 11, Week: 48, WeeksInYear: 53,
+				YearStart: date(2022, time.October, 1), YearEnd: date(2023, time.September, 30),
+				PeriodStart: date(2023, time.August, 1), PeriodEnd: date(2023, time.August, 31),
+			},
+		},
+		{
+			name:     "Monthly year first day",
+			calendar: october,
+			date:     date(2023, time.October, 1),
+			expected: Period{
+				Year: 2024, Quarter: 1, Period: 1, Week: 1, WeeksInYear: 53,
+				YearStart: date(2023, time.October, 1), YearEnd: date(2024, time.September, 30),
+				PeriodStart: date(2023, time.October, 1), PeriodEnd: date(2023, time.October, 31),
+			},
+		},
+		{
+			name:     "NRF mid year",
+			calendar: NRF,
+			date:     date(2023, time.August, 31),
+			expected: Period{
+				Year: 2023, Quarter: 3, Period: 8, Week: 31, WeeksInYear: 53,
+				YearStart: date(2023, time.January, 29), YearEnd: date(2024, time.February, 3),
+				PeriodStart: date(2023, time.August, 27), PeriodEnd: date(2023, time.September, 30),
+			},
+		},
+		{
+			name:     "NRF 53rd week",
+			calendar: NRF,
+			date:     date(2024, time.February, 3),
+			expected: Period{
+				Year: 2023, Quarter: 4, Period: 12, Week: 53, WeeksInYear: 53,
+				YearStart: date(2023, time.January, 29), YearEnd: date(2024, time.February, 3),
+				PeriodStart: date(2023, time.December, 31), PeriodEnd: date(2024, time.February, 3),
+			},
+		},
+		{
+			name:     "NRF 52 week year",
+			calendar: NRF,
+			date:     date(2024, time.February, 4),
+			expected: Period{
+				Year: 2024, Quarter: 1, Period: 1, Week: 1, WeeksInYear: 52,
+				YearStart: date(2024, time.February, 4), YearEnd: date(2025, time.February, 1),
+				PeriodStart: date(2024, time.February, 4), PeriodEnd: date(2024, time.March, 2),
+			},
+		},
+		{
+			name:     "4-4-5 ending on the last Saturday",
+			calendar: calendar445,
+			date:     date(2023, time.March, 31),
+			expected: Period{
+				Year: 2023, Quarter: 1, Period: 3, Week: 13, WeeksInYear: 52,
+				YearStart: date(2023, time.January, 1), YearEnd: date(2023, time.December, 30),
+				PeriodStart: date(2023, time.February, 26), PeriodEnd: date(2023, time.April, 1),
+			},
+		},
+		{
+			name:     "Nearest rule spilling into January",
+			calendar: Calendar{StartMonth: time.January, Pattern: "544", EndWeekday: time.Saturday, EndRule: Nearest, YearLabel: LabelEnd},
+			date:     date(2022, time.January, 1),
+			expected: Period{
+				Year: 2021, Quarter: 4, Period: 12, Week: 52, WeeksInYear: 52,
+				YearStart: date(2021, time.January, 3), YearEnd: date(2022, time.January, 1),
+				PeriodStart: date(2021, time.December, 5), PeriodEnd: date(2022, time.January, 1),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.NoError(t, tc.calendar.Validate())
+			assert.Equal(t, tc.expected, tc.calendar.Locate(tc.date))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.EqualError(t, Calendar{StartMonth: 13, YearLabel: LabelEnd}.Validate(), "invalid fiscal start month: must be between 1 and 12")
+	assert.EqualError(t, Calendar{StartMonth: 1, Pattern: "444", YearLabel: LabelEnd}.Validate(), "invalid fiscal pattern: must be 445, 454 or 544")
+	assert.EqualError(t, Calendar{StartMonth: 1, Pattern: "445", YearLabel: LabelEnd}.Validate(), "invalid fiscal end rule: must be *LAST or *NEAREST")
+}
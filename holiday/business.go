@@ -0,0 +1,158 @@
+package holiday
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Weekend holds the weekdays that are never worked
+type Weekend map[time.Weekday]bool
+
+// SaturdaySunday is the default weekend
+var SaturdaySunday = Weekend{time.Saturday: true, time.Sunday: true}
+
+var weekdayNames = map[string]time.Weekday{
+	"SUN": time.Sunday,
+	"MON": time.Monday,
+	"TUE": time.Tuesday,
+	"WED": time.Wednesday,
+	"THU": time.Thursday,
+	"FRI": time.Friday,
+	"SAT": time.Saturday,
+}
+
+// ParseWeekday reads a three letter day name, with or without a leading *
+func ParseWeekday(name string) (time.Weekday, bool) {
+	name = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(name)), "*")
+	if len(name) > 3 {
+		name = name[:3]
+	}
+
+	weekday, ok := weekdayNames[name]
+	return weekday, ok
+}
+
+// ParseWeekend reads a list of day names such as "SAT,SUN" or "*FRI *SAT";
+// *NONE means every day is worked
+func ParseWeekend(days string) (Weekend, error) {
+	if strings.TrimSpace(days) == "" {
+		return SaturdaySunday, nil
+	}
+
+	weekend := Weekend{}
+	if strings.EqualFold(strings.TrimSpace(days), "*NONE") {
+		return weekend, nil
+	}
+
+	for _, name := range strings.FieldsFunc(days, func(r rune) bool { return r == ',' || r == ' ' }) {
+		weekday, ok := ParseWeekday(name)
+		if !ok {
+			return nil, errors.New("invalid weekend day: " + name)
+		}
+		weekend[weekday] = true
+	}
+
+	if len(weekend) == 7 {
+		return nil, errors.New("invalid weekend: at least one day must be worked")
+	}
+
+	return weekend, nil
+}
+
+// NonWorkingDay is a day skipped over while counting business days
+type NonWorkingDay struct {
+	Date   time.Time
+	Reason string
+}
+
+// Why a date is not worked, or "" when it is a business day
+func (c *Calendar) nonWorkingReason(date time.Time, weekend Weekend) string {
+	if c.IsDayOff(date) {
+		return c.HolidayName(date)
+	}
+
+	if weekend[date.Weekday()] {
+		if name := c.HolidayName(date); name != "" {
+			return name
+		}
+		return date.Weekday().String()
+	}
+
+	return ""
+}
+
+// AddBusinessDays moves n business days forward, or backward for a negative
+// n, without counting the starting date, and lists the days skipped over
+func (c *Calendar) AddBusinessDays(date time.Time, n int, weekend Weekend) (time.Time, []NonWorkingDay) {
+	step := 1
+	if n < 0 {
+		step = -1
+		n = -n
+	}
+
+	var skipped []NonWorkingDay
+	for n > 0 {
+		date = date.AddDate(0, 0, step)
+		if reason := c.nonWorkingReason(date, weekend); reason != "" {
+			skipped = append(skipped, NonWorkingDay{Date: date, Reason: reason})
+			continue
+		}
+		n--
+	}
+
+	return date, skipped
+}
+
+// Business day conventions for moving a date off a non-business day
+const (
+	Unadjusted        = "*UNADJUSTED"
+	Following         = "*FOLLOWING"
+	ModifiedFollowing = "*MODFOLLOWING"
+	Preceding         = "*PRECEDING"
+	ModifiedPreceding = "*MODPRECEDING"
+)
+
+// ParseConvention normalizes a business day convention name
+func ParseConvention(name string) (string, error) {
+	convention := strings.ToUpper(strings.TrimSpace(name))
+	switch convention {
+	case "":
+		return Unadjusted, nil
+	case Unadjusted, Following, ModifiedFollowing, Preceding, ModifiedPreceding:
+		return convention, nil
+	}
+
+	return "", errors.New("invalid roll: must be *UNADJUSTED, *FOLLOWING, *MODFOLLOWING, *PRECEDING or *MODPRECEDING")
+}
+
+func (c *Calendar) nextBusinessDay(date time.Time, step int, weekend Weekend) time.Time {
+	for !c.IsBusinessDay(date, weekend) {
+		date = date.AddDate(0, 0, step)
+	}
+
+	return date
+}
+
+// Roll moves a non-business day to a business day under a convention. The
+// modified conventions turn back rather than leave the month.
+func (c *Calendar) Roll(date time.Time, convention string, weekend Weekend) time.Time {
+	switch convention {
+	case Following:
+		return c.nextBusinessDay(date, 1, weekend)
+	case Preceding:
+		return c.nextBusinessDay(date, -1, weekend)
+	case ModifiedFollowing:
+		if rolled := c.nextBusinessDay(date, 1, weekend); rolled.Month() == date.Month() {
+			return rolled
+		}
+		return c.nextBusinessDay(date, -1, weekend)
+	case ModifiedPreceding:
+		if rolled := c.nextBusinessDay(date, -1, weekend); rolled.Month() == date.Month() {
+			return rolled
+		}
+		return c.nextBusinessDay(date, 1, weekend)
+	}
+
+	return date
+}
@@ -0,0 +1,51 @@
+package holiday
+
+import "time"
+
+// US federal holidays under 5 U.S.C. 6103, with the fixed dates used
+// before the Uniform Monday Holiday Act took effect in 1971
+var US = &Calendar{
+	Name: "US",
+	Rules: []Rule{
+		{Name: "New Year's Day", Month: time.January, Day: 1, Observe: NearestWeekday},
+		{Name: "Martin Luther King Jr. Day", Month: time.January, Weekday: time.Monday, Nth: 3, FromYear: 1986},
+		{Name: "Washington's Birthday", Month: time.February, Day: 22, ToYear: 1970, Observe: NearestWeekday},
+		{Name: "Washington's Birthday", Month: time.February, Weekday: time.Monday, Nth: 3, FromYear: 1971},
+		{Name: "Memorial Day", Month: time.May, Day: 30, ToYear: 1970, Observe: NearestWeekday},
+		{Name: "Memorial Day", Month: time.May, Weekday: time.Monday, Nth: -1, FromYear: 1971},
+		{Name: "Juneteenth National Independence Day", Month: time.June, Day: 19, FromYear: 2021, Observe: NearestWeekday},
+		{Name: "Independence Day", Month: time.July, Day: 4, Observe: NearestWeekday},
+		{Name: "Labor Day", Month: time.September, Weekday: time.Monday, Nth: 1, FromYear: 1894},
+		{Name: "Columbus Day", Month: time.October, Day: 12, FromYear: 1937, ToYear: 1970, Observe: NearestWeekday},
+		{Name: "Columbus Day", Month: time.October, Weekday: time.Monday, Nth: 2, FromYear: 1971},
+		{Name: "Veterans Day", Month: time.November, Day: 11, FromYear: 1938, ToYear: 1970, Observe: NearestWeekday},
+		{Name: "Veterans Day", Month: time.October, Weekday: time.Monday, Nth: 4, FromYear: 1971, ToYear: 1977},
+		{Name: "Veterans Day", Month: time.November, Day: 11, FromYear: 1978, Observe: NearestWeekday},
+		{Name: "Thanksgiving Day", Month: time.November, Weekday: time.Thursday, Nth: 4},
+		{Name: "Christmas Day", Month: time.December, Day: 25, Observe: NearestWeekday},
+	},
+}
+
+// UK bank holidays for England and Wales
+var UK = &Calendar{
+	Name: "UK",
+	Rules: []Rule{
+		{Name: "New Year's Day", Month: time.January, Day: 1, FromYear: 1974, Observe: Substitute},
+		{Name: "Good Friday", Easter: true, EasterOffset: -2},
+		{Name: "Easter Monday", Easter: true, EasterOffset: 1},
+		{Name: "Early May bank holiday", Month: time.May, Weekday: time.Monday, Nth: 1, FromYear: 1978},
+		{Name: "Spring bank holiday", Month: time.May, Weekday: time.Monday, Nth: -1, FromYear: 1971},
+		{Name: "Summer bank holiday", Month: time.August, Weekday: time.Monday, Nth: -1, FromYear: 1971},
+		{Name: "Christmas Day", Month: time.December, Day: 25, Observe: Substitute},
+		{Name: "Boxing Day", Month: time.December, Day: 26, Observe: Substitute},
+	},
+}
+
+// None has no holidays, leaving only weekends as non-business days
+var None = &Calendar{Name: "NONE"}
+
+func init() {
+	Register(US)
+	Register(UK)
+	Register(None)
+}
@@ -0,0 +1,260 @@
+// Package holiday computes public holidays from named calendars of rules.
+package holiday
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Observance decides which day is taken off when a holiday falls on a weekend
+type Observance int
+
+const (
+	// Actual keeps the holiday on its own date
+	Actual Observance = iota
+	// NearestWeekday moves Saturday to Friday and Sunday to Monday
+	NearestWeekday
+	// Substitute moves a weekend holiday to the next weekday that is not
+	// already a holiday
+	Substitute
+)
+
+// Rule describes when a holiday falls in a given year. A rule is either a
+// fixed Month and Day, the Nth Weekday of Month (Nth -1 for the last), or
+// EasterOffset days from Easter Sunday when Easter is set.
+type Rule struct {
+	Name         string
+	Month        time.Month
+	Day          int
+	Weekday      time.Weekday
+	Nth          int
+	Easter       bool
+	EasterOffset int
+	FromYear     int
+	ToYear       int
+	Observe      Observance
+}
+
+// Holiday is one occurrence of a rule; Date is the day off, which differs
+// from Actual when the holiday is observed on another day
+type Holiday struct {
+	Name   string
+	Date   time.Time
+	Actual time.Time
+
+	observe Observance
+}
+
+func (h Holiday) IsObserved() bool {
+	return !h.Date.Equal(h.Actual)
+}
+
+// Rules must not change once a calendar is in use: the holidays of each
+// year are worked out once and kept
+type Calendar struct {
+	Name  string
+	Rules []Rule
+
+	years sync.Map // year -> []Holiday
+}
+
+func (r Rule) date(year int) (time.Time, bool) {
+	if year < r.FromYear || (r.ToYear != 0 && year > r.ToYear) {
+		return time.Time{}, false
+	}
+
+	switch {
+	case r.Easter:
+		return Easter(year).AddDate(0, 0, r.EasterOffset), true
+	case r.Nth != 0:
+		return NthWeekday(year, r.Month, r.Weekday, r.Nth), true
+	default:
+		return time.Date(year, r.Month, r.Day, 0, 0, 0, 0, time.UTC), true
+	}
+}
+
+// NthWeekday returns the nth weekday of a month, or the last one for n = -1
+func NthWeekday(year int, month time.Month, weekday time.Weekday, n int) time.Time {
+	if n < 0 {
+		last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+		back := (int(last.Weekday()) - int(weekday) + 7) % 7
+		return last.AddDate(0, 0, -back-7*(-n-1))
+	}
+
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	ahead := (int(weekday) - int(first.Weekday()) + 7) % 7
+	return first.AddDate(0, 0, ahead+7*(n-1))
+}
+
+// Easter returns Easter Sunday in the Gregorian calendar
+func Easter(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+func isWeekend(date time.Time) bool {
+	return date.Weekday() == time.Saturday || date.Weekday() == time.Sunday
+}
+
+// Holidays generated by the rules for one year, wherever they are observed.
+// The slice is shared between callers and must not be changed.
+func (c *Calendar) generate(year int) []Holiday {
+	if holidays, ok := c.years.Load(year); ok {
+		return holidays.([]Holiday)
+	}
+
+	holidays, _ := c.years.LoadOrStore(year, c.applyRules(year))
+	return holidays.([]Holiday)
+}
+
+func (c *Calendar) applyRules(year int) []Holiday {
+	var holidays []Holiday
+	taken := map[time.Time]bool{}
+
+	for _, rule := range c.Rules {
+		if date, ok := rule.date(year); ok {
+			holidays = append(holidays, Holiday{Name: rule.Name, Date: date, Actual: date, observe: rule.Observe})
+			if !isWeekend(date) {
+				taken[date] = true
+			}
+		}
+	}
+
+	sort.SliceStable(holidays, func(i, j int) bool { return holidays[i].Actual.Before(holidays[j].Actual) })
+
+	for i, h := range holidays {
+		if !isWeekend(h.Actual) {
+			continue
+		}
+
+		switch h.observe {
+		case NearestWeekday:
+			if h.Actual.Weekday() == time.Saturday {
+				holidays[i].Date = h.Actual.AddDate(0, 0, -1)
+			} else {
+				holidays[i].Date = h.Actual.AddDate(0, 0, 1)
+			}
+		case Substitute:
+			date := h.Actual
+			for isWeekend(date) || taken[date] {
+				date = date.AddDate(0, 0, 1)
+			}
+			taken[date] = true
+			holidays[i].Date = date
+		}
+	}
+
+	return holidays
+}
+
+// Holidays lists the days off falling in a year in date order. A holiday
+// observed across a year end, such as New Year's Day kept on December 31,
+// belongs to the year it is observed in.
+func (c *Calendar) Holidays(year int) []Holiday {
+	var holidays []Holiday
+	for y := year - 1; y <= year+1; y++ {
+		for _, h := range c.generate(y) {
+			if h.Date.Year() == year {
+				holidays = append(holidays, h)
+			}
+		}
+	}
+
+	sort.SliceStable(holidays, func(i, j int) bool { return holidays[i].Date.Before(holidays[j].Date) })
+
+	return holidays
+}
+
+// Find returns the holiday on a date, whether it is the day off or the
+// holiday's own date
+func (c *Calendar) Find(date time.Time) (Holiday, bool) {
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	var actual *Holiday
+	for y := date.Year() - 1; y <= date.Year()+1; y++ {
+		for _, h := range c.generate(y) {
+			if h.Date.Equal(date) {
+				return h, true
+			}
+			if h.Actual.Equal(date) && actual == nil {
+				found := h
+				actual = &found
+			}
+		}
+	}
+
+	if actual != nil {
+		return *actual, true
+	}
+
+	return Holiday{}, false
+}
+
+// HolidayName describes the holiday on a date, marking days kept in its place
+func (c *Calendar) HolidayName(date time.Time) string {
+	h, ok := c.Find(date)
+	if !ok {
+		return ""
+	}
+
+	if h.IsObserved() && h.Date.Equal(date) {
+		return h.Name + " (observed)"
+	}
+
+	return h.Name
+}
+
+func (c *Calendar) IsHoliday(date time.Time) bool {
+	_, ok := c.Find(date)
+	return ok
+}
+
+// IsDayOff reports whether a date is a holiday's day off
+func (c *Calendar) IsDayOff(date time.Time) bool {
+	h, ok := c.Find(date)
+	return ok && h.Date.Equal(time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC))
+}
+
+func (c *Calendar) IsBusinessDay(date time.Time, weekend Weekend) bool {
+	return !weekend[date.Weekday()] && !c.IsDayOff(date)
+}
+
+var calendars = map[string]*Calendar{}
+
+// Register makes a calendar selectable by name
+func Register(c *Calendar) {
+	calendars[strings.ToUpper(c.Name)] = c
+}
+
+// Lookup finds a registered calendar by name, ignoring case
+func Lookup(name string) (*Calendar, bool) {
+	c, ok := calendars[strings.ToUpper(strings.TrimSpace(name))]
+	return c, ok
+}
+
+// Names lists the registered calendars
+func Names() []string {
+	names := make([]string, 0, len(calendars))
+	for _, c := range calendars {
+		names = append(names, c.Name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,156 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestEaster(t *testing.T) {
+	assert.Equal(t, date(2000, time.April, 23), Easter(2000))
+	assert.Equal(t, date(2023, time.April, 9), Easter(2023))
+	assert.Equal(t, date(2024, time.March, 31), Easter(2024))
+	assert.Equal(t, date(2025, time.April, 20), Easter(2025))
+}
+
+func TestNthWeekday(t *testing.T) {
+	assert.Equal(t, date(2024, time.January, 15), NthWeekday(2024, time.January, time.Monday, 3))
+	assert.Equal(t, date(2024, time.May, 27), NthWeekday(2024, time.May, time.Monday, -1))
+	assert.Equal(t, date(2023, time.November, 23), NthWeekday(2023, time.November, time.Thursday, 4))
+	assert.Equal(t, date(2023, time.September, 1), NthWeekday(2023, time.September, time.Friday, 1))
+}
+
+func TestHolidayName(t *testing.T) {
+	testCases := []struct {
+		name          string
+		calendar      *Calendar
+		date          time.Time
+		expectedName  string
+		expectedOpen  bool
+		expectedFound bool
+	}{
+		{"Fixed date on a weekday", US, date(2023, time.December, 25), "Christmas Day", false, true},
+		{"Sunday holiday", US, date(2021, time.July, 4), "Independence Day", false, true},
+		{"Observed on Monday", US, date(2021, time.July, 5), "Independence Day (observed)", false, true},
+		{"Observed in the previous year", US, date(2021, time.December, 31), "New Year's Day (observed)", false, true},
+		{"Juneteenth before it existed", US, date(2020, time.June, 19), "", true, false},
+		{"Nth weekday", US, date(2024, time.January, 15), "Martin Luther King Jr. Day", false, true},
+		{"Before the Monday holiday act", US, date(1970, time.May, 29), "Memorial Day (observed)", false, true},
+		{"Ordinary day", US, date(2023, time.August, 31), "", true, false},
+		{"Easter based", UK, date(2024, time.March, 29), "Good Friday", false, true},
+		{"Substitute after Saturday Christmas", UK, date(2021, time.December, 27), "Christmas Day (observed)", false, true},
+		{"Substitute skips a taken day", UK, date(2021, time.December, 28), "Boxing Day (observed)", false, true},
+		{"Sunday Christmas waits for Boxing Day", UK, date(2022, time.December, 27), "Christmas Day (observed)", false, true},
+		{"No holidays", None, date(2023, time.December, 25), "", true, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedName, tc.calendar.HolidayName(tc.date))
+			assert.Equal(t, tc.expectedFound, tc.calendar.IsHoliday(tc.date))
+			assert.Equal(t, tc.expectedOpen, tc.calendar.IsBusinessDay(tc.date, SaturdaySunday))
+		})
+	}
+}
+
+func TestHolidays(t *testing.T) {
+	holidays := US.Holidays(2021)
+
+	assert.Len(t, holidays, 12)
+	assert.Equal(t, date(2021, time.January, 1), holidays[0].Date)
+	assert.Equal(t, "New Year's Day", holidays[11].Name)
+	assert.Equal(t, date(2021, time.December, 31), holidays[11].Date)
+	assert.Equal(t, date(2022, time.January, 1), holidays[11].Actual)
+
+	assert.Len(t, US.Holidays(2022), 10)
+}
+
+func TestLookup(t *testing.T) {
+	calendar, ok := Lookup("uk")
+	assert.True(t, ok)
+	assert.Equal(t, UK, calendar)
+
+	_, ok = Lookup("XX")
+	assert.False(t, ok)
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	testCases := []struct {
+		name            string
+		calendar        *Calendar
+		start           time.Time
+		days            int
+		weekend         Weekend
+		expectedDate    time.Time
+		expectedSkipped []string
+	}{
+		{"Over a weekend", US, date(2023, time.September, 22), 1, SaturdaySunday, date(2023, time.September, 25), []string{"Saturday", "Sunday"}},
+		{"Over Christmas", US, date(2023, time.December, 22), 2, SaturdaySunday, date(2023, time.December, 27), []string{"Saturday", "Sunday", "Christmas Day"}},
+		{"Backward over an observed holiday", US, date(2021, time.July, 6), -1, SaturdaySunday, date(2021, time.July, 2), []string{"Independence Day (observed)", "Independence Day", "Saturday"}},
+		{"Friday and Saturday weekend", None, date(2023, time.September, 21), 1, Weekend{time.Friday: true, time.Saturday: true}, date(2023, time.September, 24), []string{"Friday", "Saturday"}},
+		{"Zero days", US, date(2023, time.September, 23), 0, SaturdaySunday, date(2023, time.September, 23), nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, skipped := tc.calendar.AddBusinessDays(tc.start, tc.days, tc.weekend)
+
+			var reasons []string
+			for _, day := range skipped {
+				reasons = append(reasons, day.Reason)
+			}
+
+			assert.Equal(t, tc.expectedDate, result)
+			assert.Equal(t, tc.expectedSkipped, reasons)
+		})
+	}
+}
+
+func TestParseWeekend(t *testing.T) {
+	weekend, err := ParseWeekend("*FRI *SAT")
+	assert.NoError(t, err)
+	assert.Equal(t, Weekend{time.Friday: true, time.Saturday: true}, weekend)
+
+	weekend, err = ParseWeekend("")
+	assert.NoError(t, err)
+	assert.Equal(t, SaturdaySunday, weekend)
+
+	_, err = ParseWeekend("SAT,XYZ")
+	assert.EqualError(t, err, "invalid weekend day: XYZ")
+
+	_, err = ParseWeekend("SUN,MON,TUE,WED,THU,FRI,SAT")
+	assert.EqualError(t, err, "invalid weekend: at least one day must be worked")
+}
+
+func TestRoll(t *testing.T) {
+	testCases := []struct {
+		name       string
+		date       time.Time
+		convention string
+		expected   time.Time
+	}{
+		{"Business day is left alone", date(2023, time.September, 21), ModifiedFollowing, date(2023, time.September, 21)},
+		{"Unadjusted", date(2023, time.September, 23), Unadjusted, date(2023, time.September, 23)},
+		{"Following", date(2023, time.September, 23), Following, date(2023, time.September, 25)},
+		{"Following over a holiday", date(2023, time.December, 23), Following, date(2023, time.December, 26)},
+		{"Preceding", date(2023, time.September, 23), Preceding, date(2023, time.September, 22)},
+		{"Modified following stays in the month", date(2023, time.September, 30), ModifiedFollowing, date(2023, time.September, 29)},
+		{"Modified following within the month", date(2023, time.September, 16), ModifiedFollowing, date(2023, time.September, 18)},
+		{"Modified preceding stays in the month", date(2023, time.October, 1), ModifiedPreceding, date(2023, time.October, 2)},
+		{"Modified preceding within the month", date(2023, time.October, 8), ModifiedPreceding, date(2023, time.October, 6)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, US.Roll(tc.date, tc.convention, SaturdaySunday))
+		})
+	}
+
+	_, err := ParseConvention("*NEAREST")
+	assert.Error(t, err)
+}
@@ -131,5 +131,25 @@ document.addEventListener('DOMContentLoaded', function () {
     formattedDateElement.textContent = formattedDate;
     formattedDateElementUTC.textContent = formattedDateUTC;
     formattedTimeElement.textContent = formattedPageTime;
+
+    // The server owns today; the browser clock is only a fallback
+    fetch('https://dolotsoflittlethings.com:8010/api/SystemDate', {
+      method: 'POST',
+      body: JSON.stringify({}),
+      headers: {
+        'Content-Type': 'application/json'
+      }
+    })
+      .then(response => response.json())
+      .then(data => {
+        const results = data.results;
+
+        formattedDateElement.textContent = results.SystemDate;
+        formattedDateElementUTC.textContent = results.JobDate;
+        formattedTimeElement.textContent = results.SystemTime;
+      })
+      .catch(error => {
+        console.error(error);
+      });
   }
 });
\ No newline at end of file
@@ -1,10 +1,12 @@
 package main
 
 import (
+	"date_calculation/clock"
 	"date_calculation/controller"
 	"date_calculation/middleware"
 
 	"fmt"
+	"os"
 
 	"github.com/gin-gonic/gin"
 )
@@ -14,6 +16,20 @@ func main() {
 }
 
 func serveApplication() {
+	// *LEGACY restricts 100 year dates to the 5 digit host field
+	if hydRange := os.Getenv("DATE40_HYD_RANGE"); hydRange != "" {
+		if err := controller.SetDefaultHundredYearRange(hydRange); err != nil {
+			fmt.Println("Error:", err)
+			os.Exit(1)
+		}
+	}
+
+	// Time zone for SYSDATE, and a frozen or shifted "today" for test systems
+	if err := clock.Configure(os.Getenv("DATE40_TZ"), os.Getenv("DATE40_FREEZE"), os.Getenv("DATE40_SHIFT")); err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(1)
+	}
+
 	router := gin.Default()
 	router.SetTrustedProxies([]string{"127.0.0.1", "23.254.209.206"})
 
@@ -23,6 +39,21 @@ func serveApplication() {
 	publicRoutes.Use(middleware.CORSMiddleware())
 	publicRoutes.POST("/CalcCalendarDate", controller.CalcCalendarDate)
 	publicRoutes.POST("/CalcHundredYearDate", controller.CalcHundreYearDate)
+	publicRoutes.POST("/CalcJulianDate", controller.CalcJulianDate)
+	publicRoutes.POST("/ConvertDate", controller.ConvertDate)
+	publicRoutes.POST("/AddDuration", controller.AddDuration)
+	publicRoutes.POST("/DateDiff", controller.DateDiff)
+	publicRoutes.POST("/AddBusinessDays", controller.AddBusinessDays)
+	publicRoutes.POST("/DayCount", controller.DayCount)
+	publicRoutes.POST("/PayPeriod", controller.PayPeriod)
+	publicRoutes.POST("/PaymentTerms", controller.PaymentTerms)
+	publicRoutes.POST("/YearInfo", controller.YearInfo)
+	publicRoutes.POST("/MonthCalendar", controller.MonthCalendar)
+	publicRoutes.POST("/DateRange", controller.DateRange)
+	publicRoutes.POST("/SystemDate", controller.SystemDate)
+	publicRoutes.POST("/Recurrence", controller.Recurrence)
+	publicRoutes.POST("/JobSchedule", controller.JobSchedule)
+	publicRoutes.POST("/Age", controller.Age)
 
 	router.RunTLS(":8010", "./fullchain.pem", "privkey.pem")
 	fmt.Println("Server running on port 8010")
@@ -0,0 +1,9 @@
+package models
+
+type ConversionOptions struct {
+	Calendar string         `json:"calendar"` // holiday calendar: US (default), UK or NONE
+	Weekend  string         `json:"weekend"`  // days never worked: SAT,SUN (default), FRI,SAT or *NONE
+	Roll     string         `json:"roll"`     // *FOLLOWING, *MODFOLLOWING, *PRECEDING, *MODPRECEDING or *UNADJUSTED
+	Fiscal   *FiscalOptions `json:"fiscal"`   // adds the fiscal period to the results
+	WindowOptions
+}
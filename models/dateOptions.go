@@ -0,0 +1,8 @@
+package models
+
+type DateOptions struct {
+	Format string `json:"format"` // *AUTO (default), *HYD or a CVTDAT format such as *MDYY
+	Order  string `json:"order"`  // MDY, DMY or YMD breaks ties in *AUTO
+	Locale string `json:"locale"` // en-US, en-GB, ja-JP... when order is not given
+	ConversionOptions
+}
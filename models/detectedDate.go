@@ -0,0 +1,6 @@
+package models
+
+type DetectedDate struct {
+	Format  string        `json:"Format"` // *ISO, *USA, *MDY...
+	Results OutputResults `json:"Results"`
+}
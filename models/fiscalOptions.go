@@ -0,0 +1,10 @@
+package models
+
+type FiscalOptions struct {
+	Preset     string `json:"preset"`     // *NRF retail 4-5-4 calendar; other fields are ignored
+	StartMonth int    `json:"startMonth"` // first month of the fiscal year, 1-12
+	Pattern    string `json:"pattern"`    // 445, 454 or 544 for 52/53-week years; empty for calendar months
+	EndWeekday string `json:"endWeekday"` // weekday the 52/53-week year ends on, e.g. SAT
+	EndRule    string `json:"endRule"`    // *LAST (default) or *NEAREST weekday to the month end
+	YearLabel  string `json:"yearLabel"`  // *END (default) or *START: calendar year naming the fiscal year
+}
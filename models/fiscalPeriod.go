@@ -0,0 +1,13 @@
+package models
+
+type FiscalPeriod struct {
+	Year        int    `json:"Year"`        // FY2023
+	Quarter     int    `json:"Quarter"`     // 1-4
+	Period      int    `json:"Period"`      // 1-12
+	Week        int    `json:"Week"`        // 1-53
+	WeeksInYear int    `json:"WeeksInYear"` // 52 or 53
+	YearStart   string `json:"YearStart"`   // 2023-01-29
+	YearEnd     string `json:"YearEnd"`     // 2024-02-03
+	PeriodStart string `json:"PeriodStart"` // 2023-08-27
+	PeriodEnd   string `json:"PeriodEnd"`   // 2023-09-30
+}
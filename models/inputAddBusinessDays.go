@@ -0,0 +1,7 @@
+package models
+
+type InputAddBusinessDays struct {
+	Date string `json:"date"`
+	Days int    `json:"days"` // negative to move backward
+	DateOptions
+}
@@ -0,0 +1,10 @@
+package models
+
+type InputAddDuration struct {
+	Date     string `json:"date"`
+	Years    int    `json:"years"`
+	Months   int    `json:"months"`
+	Days     int    `json:"days"`     // a HYD offset
+	Overflow string `json:"overflow"` // *CLAMP (default), *EOM, *ROLL or *ERROR
+	DateOptions
+}
@@ -0,0 +1,9 @@
+package models
+
+type InputAge struct {
+	Date    string `json:"date"`    // birth or hire date
+	AsOf    string `json:"asOf"`    // reference date, or an expression such as EOY; defaults to the job date
+	Session string `json:"session"` // job whose date stands in for today
+	LeapDay string `json:"leapDay"` // *FEB28 (default) or *MAR1: when a Feb 29 anniversary falls in common years
+	DateOptions
+}
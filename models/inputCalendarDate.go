@@ -1,5 +1,11 @@
 package models
 
 type InputCalendarDate struct {
-	Date string `json:"date"`
+	Date    string `json:"date"`
+	Mode    string `json:"mode"`    // "auto" detects the input format, "relative" reads expressions such as EOM or +30
+	Anchor  string `json:"anchor"`  // day relative expressions count from; defaults to the job date
+	Session string `json:"session"` // job whose date stands in for today
+	Order   string `json:"order"`   // MDY, DMY or YMD breaks ties in auto mode
+	Locale  string `json:"locale"`  // en-US, en-GB, ja-JP... when order is not given
+	ConversionOptions
 }
@@ -0,0 +1,9 @@
+package models
+
+type InputConvertDate struct {
+	Date    string `json:"date"`
+	FromFmt string `json:"fromFmt"`
+	ToFmt   string `json:"toFmt"`
+	ToSep   string `json:"toSep"`
+	ConversionOptions
+}
@@ -0,0 +1,8 @@
+package models
+
+type InputDateDiff struct {
+	From         string `json:"from"`
+	To           string `json:"to"`
+	BusinessDays bool   `json:"businessDays"` // also count business days under the calendar and weekend
+	DateOptions
+}
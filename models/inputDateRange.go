@@ -0,0 +1,10 @@
+package models
+
+type InputDateRange struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Step     string `json:"step"`     // *DAYS (default), *WEEKS, *MONTHS or *BUSINESSDAYS
+	Interval int    `json:"interval"` // steps between rows, default 1
+	Output   string `json:"output"`   // *NDJSON (default) or *CSV
+	DateOptions
+}
@@ -0,0 +1,8 @@
+package models
+
+type InputDayCount struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Maturity bool   `json:"maturity"` // to is the maturity date, for 30E/360 ISDA
+	DateOptions
+}
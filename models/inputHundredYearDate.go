@@ -2,4 +2,6 @@ package models
 
 type InputHundredYearDate struct {
 	HundredYear string `json:"date"`
+	HydRange    string `json:"hydRange"` // *EXTENDED (default) or *LEGACY for 0-99999; naming *EXTENDED reads 0 and 99999 as days
+	ConversionOptions
 }
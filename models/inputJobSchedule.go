@@ -0,0 +1,13 @@
+package models
+
+type InputJobSchedule struct {
+	Frequency string   `json:"frq"`       // *ONCE, *WEEKLY or *MONTHLY
+	ScdDate   string   `json:"scdDate"`   // a date, *CURRENT (default), *MONTHSTR, *MONTHEND or *NONE
+	ScdDay    []string `json:"scdDay"`    // *MON ... *SUN or *ALL, with scdDate *NONE
+	RelDayMon []string `json:"relDayMon"` // 1-5 or *LAST, with frq *MONTHLY and scdDay
+	ScdTime   string   `json:"scdTime"`   // HHMMSS; once passed the current date no longer runs
+	OmitDate  []string `json:"omitDate"`  // up to 20 dates the job is not run
+	Runs      int      `json:"runs"`      // run dates returned, default 10
+	Session   string   `json:"session"`   // job whose date stands in for the current date
+	DateOptions
+}
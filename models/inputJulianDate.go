@@ -0,0 +1,6 @@
+package models
+
+type InputJulianDate struct {
+	Julian string `json:"date"`
+	ConversionOptions
+}
@@ -0,0 +1,8 @@
+package models
+
+type InputMonthCalendar struct {
+	Year      int    `json:"year"`      // 1-9999
+	Month     int    `json:"month"`     // 1-12
+	WeekStart string `json:"weekStart"` // SUN (default), MON...
+	ConversionOptions
+}
@@ -0,0 +1,10 @@
+package models
+
+type InputPayPeriod struct {
+	Date       string `json:"date"`
+	Anchor     string `json:"anchor"`     // first day of any pay period; required for *BIWEEKLY
+	Frequency  string `json:"frequency"`  // *WEEKLY, *BIWEEKLY, *SEMIMONTHLY or *MONTHLY
+	WeekEnding string `json:"weekEnding"` // SAT (default), FRI...
+	PayLag     int    `json:"payLag"`     // days from period end to pay date
+	DateOptions
+}
@@ -0,0 +1,7 @@
+package models
+
+type InputPaymentTerms struct {
+	Date  string `json:"date"`  // invoice date
+	Terms string `json:"terms"` // NET 30, EOM+10, MFI 15, 2/10 NET 30...
+	DateOptions
+}
@@ -0,0 +1,9 @@
+package models
+
+type InputRecurrence struct {
+	DtStart string   `json:"dtstart"` // first date, in any supported form or iCalendar 20230808T090000Z
+	RRule   string   `json:"rrule"`   // FREQ=MONTHLY;BYDAY=-1FR;COUNT=6
+	ExDate  []string `json:"exdate"`  // dates left out of the occurrences
+	Limit   int      `json:"limit"`   // occurrences returned, default and at most 1000
+	DateOptions
+}
@@ -0,0 +1,8 @@
+package models
+
+type InputSystemDate struct {
+	Session string `json:"session"` // job the job date belongs to
+	JobDate string `json:"jobDate"` // changes the job date, like CHGJOB DATE
+	Reset   bool   `json:"reset"`   // returns the job date to the system date
+	DateOptions
+}
@@ -0,0 +1,6 @@
+package models
+
+type InputYearInfo struct {
+	Year int `json:"year"` // 1-9999
+	ConversionOptions
+}
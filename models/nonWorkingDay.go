@@ -0,0 +1,7 @@
+package models
+
+type NonWorkingDay struct {
+	Date        string `json:"Date"`        // 2023-12-25
+	HundredYear string `json:"HundredYear"` // 45284
+	Reason      string `json:"Reason"`      // Saturday, Christmas Day
+}
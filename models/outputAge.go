@@ -0,0 +1,16 @@
+package models
+
+type OutputAge struct {
+	AnniversaryYears           int    `json:"AnniversaryYears"`  // years completed on the next anniversary
+	AsOf                       string `json:"AsOf"`              // 2023-08-31
+	Days                       int    `json:"Days"`              // 1/31/1990 -> 3/15/2023: 33 years 1 month 15 days
+	DaysToAnniversary          int    `json:"DaysToAnniversary"` // 0 on the anniversary itself
+	ErrorFlag                  string `json:"ErrorFlag"`
+	ErrorText                  string `json:"ErrorText"`
+	LeapDay                    string `json:"LeapDay"` // *FEB28 or *MAR1
+	Months                     int    `json:"Months"`
+	NextAnniversary            string `json:"NextAnniversary"`            // 2024-01-31
+	NextAnniversaryHundredYear string `json:"NextAnniversaryHundredYear"` // 45321
+	TotalDays                  int    `json:"TotalDays"`                  // difference of the two HYDs
+	Years                      int    `json:"Years"`
+}
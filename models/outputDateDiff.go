@@ -0,0 +1,15 @@
+package models
+
+type OutputDateDiff struct {
+	BusinessDays       *int           `json:"BusinessDays,omitempty"` // days in the span off the weekend and calendar holidays
+	CalendarDays       int            `json:"CalendarDays"`           // 1/31/2023 -> 3/15/2023: 1 month 15 days
+	CalendarMonths     int            `json:"CalendarMonths"`
+	CalendarYears      int            `json:"CalendarYears"`
+	Days               int            `json:"Days"` // difference of the two HYDs
+	ErrorFlag          string         `json:"ErrorFlag"`
+	ErrorText          string         `json:"ErrorText"`
+	InclusiveDays      int            `json:"InclusiveDays"` // counting both ends
+	WeekdayCounts      map[string]int `json:"WeekdayCounts"` // Monday -> 5, both ends included
+	Weeks              int            `json:"Weeks"`
+	WeeksRemainderDays int            `json:"WeeksRemainderDays"`
+}
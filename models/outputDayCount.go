@@ -0,0 +1,14 @@
+package models
+
+type DayCount struct {
+	Convention   string  `json:"Convention"`   // 30/360 US
+	Days         int     `json:"Days"`         // days counted by the convention
+	YearFraction float64 `json:"YearFraction"` // 0.5 for half a year
+}
+
+type OutputDayCount struct {
+	ActualDays  int        `json:"ActualDays"` // difference of the two HYDs
+	Conventions []DayCount `json:"Conventions"`
+	ErrorFlag   string     `json:"ErrorFlag"`
+	ErrorText   string     `json:"ErrorText"`
+}
@@ -0,0 +1,9 @@
+package models
+
+type OutputJobSchedule struct {
+	Command     string `json:"Command"`     // ADDJOBSCDE FRQ(*MONTHLY) SCDDATE(*NONE) SCDDAY(*FRI) RELDAYMON(3)
+	Count       int    `json:"Count"`       // run dates returned
+	CurrentDate string `json:"CurrentDate"` // 08312023, the date runs are found from
+	ErrorFlag   string `json:"ErrorFlag"`
+	ErrorText   string `json:"ErrorText"`
+}
@@ -0,0 +1,20 @@
+package models
+
+type CalendarCell struct {
+	AcscJulian    string `json:"AcscJulian"` // 23-243
+	Day           int    `json:"Day"`
+	HolidayName   string `json:"HolidayName"`
+	HundredYear   string `json:"HundredYear"` // 45168
+	IsBusinessDay bool   `json:"IsBusinessDay"`
+	IsHoliday     bool   `json:"IsHoliday"`
+}
+
+type OutputMonthCalendar struct {
+	ErrorFlag string            `json:"ErrorFlag"`
+	ErrorText string            `json:"ErrorText"`
+	Month     int               `json:"Month"`
+	MonthName string            `json:"MonthName"` // August
+	Weekdays  []string          `json:"Weekdays"`  // column headings: SUN. MON. ...
+	Weeks     [][]*CalendarCell `json:"Weeks"`     // null outside the month
+	Year      int               `json:"Year"`
+}
@@ -0,0 +1,10 @@
+package models
+
+type OutputPayPeriod struct {
+	ErrorFlag     string `json:"ErrorFlag"`
+	ErrorText     string `json:"ErrorText"`
+	Frequency     string `json:"Frequency"`     // *BIWEEKLY
+	PayDateRolled bool   `json:"PayDateRolled"` // pay date moved off a non-working day
+	Period        int    `json:"Period"`        // numbered by the year the period ends in
+	PeriodsInYear int    `json:"PeriodsInYear"` // 26 or 27 biweekly
+}
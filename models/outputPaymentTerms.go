@@ -0,0 +1,10 @@
+package models
+
+type OutputPaymentTerms struct {
+	DiscountDays    int     `json:"DiscountDays"`    // days from invoice to the discount due date
+	DiscountPercent float64 `json:"DiscountPercent"` // 2 for 2/10 NET 30
+	ErrorFlag       string  `json:"ErrorFlag"`
+	ErrorText       string  `json:"ErrorText"`
+	NetDays         int     `json:"NetDays"` // days from invoice to the net due date
+	Terms           string  `json:"Terms"`   // canonical form: 2/10 NET 30
+}
@@ -0,0 +1,9 @@
+package models
+
+type OutputRecurrence struct {
+	Count     int    `json:"Count"` // occurrences returned
+	ErrorFlag string `json:"ErrorFlag"`
+	ErrorText string `json:"ErrorText"`
+	Rule      string `json:"Rule"`      // FREQ=MONTHLY;BYDAY=-1FR;COUNT=6
+	Truncated bool   `json:"Truncated"` // the rule goes on past the limit
+}
@@ -1,15 +1,39 @@
 package models
 
 type OutputResults struct {
-	AcscEuropean          string `json:"AcscEuropean"`      // 15.07.23
-	AcscHundredYear       string `json:"AcscHundredYear"`   // 4/15/73 -> 26768
-	AcscInternational     string `json:"AcscInternational"` // 23-07-15
-	AcscJulian            string `json:"AcscJulian"`        // 8/31/2023 -> 23-243
-	AcscUsaStandard       string `json:"AcscUsaStandard"`   // 7/15/23
-	DayOfWeek             string `json:"DayOfWeek"`         // THU FRI
-	ErrorFlag             string `json:"ErrorFlag"`         // ???
-	ErrorText             string `json:"ErrorText"`
-	EuropeanStandard      string `json:"EuropeanStandard"`      // 15.07.2023
-	InternationalStandard string `json:"InternationalStandard"` // 2023-07-15
-	UsaStandard           string `json:"UsaStandard"`           // 7/15/2023
+	AcscEuropean            string          `json:"AcscEuropean"`      // 15.07.23
+	AcscHundredYear         string          `json:"AcscHundredYear"`   // 4/15/73 -> 26768
+	AcscInternational       string          `json:"AcscInternational"` // 23-07-15
+	AcscJulian              string          `json:"AcscJulian"`        // 8/31/2023 -> 23-243
+	AcscOutsideWindow       bool            `json:"AcscOutsideWindow"` // short formats cannot be read back
+	AcscUsaStandard         string          `json:"AcscUsaStandard"`   // 7/15/23
+	AcscWindow              string          `json:"AcscWindow"`        // 1940-2039
+	DayName                 string          `json:"DayName"`           // Thursday
+	DayOfWeek               string          `json:"DayOfWeek"`         // THU FRI
+	DayOfYear               int             `json:"DayOfYear"`         // 243
+	DaysInMonth             int             `json:"DaysInMonth"`       // 28-31
+	Db2DayOfWeek            int             `json:"Db2DayOfWeek"`      // 1 Sunday - 7 Saturday; IsoWeekday counts from Monday
+	ErrorFlag               string          `json:"ErrorFlag"`         // ???
+	ErrorText               string          `json:"ErrorText"`
+	EuropeanStandard        string          `json:"EuropeanStandard"` // 15.07.2023
+	Fiscal                  *FiscalPeriod   `json:"Fiscal,omitempty"`
+	HolidayName             string          `json:"HolidayName"`           // Independence Day (observed)
+	InternationalStandard   string          `json:"InternationalStandard"` // 2023-07-15
+	IsBusinessDay           bool            `json:"IsBusinessDay"`
+	IsHoliday               bool            `json:"IsHoliday"`
+	IsoWeek                 int             `json:"IsoWeek"`     // 28
+	IsoWeekDate             string          `json:"IsoWeekDate"` // 2023-W28-6
+	IsoWeekYear             int             `json:"IsoWeekYear"` // differs from the year around January 1
+	IsoWeekday              int             `json:"IsoWeekday"`  // 1 Monday - 7 Sunday
+	LeapYear                bool            `json:"LeapYear"`
+	MonthEndHundredYear     string          `json:"MonthEndHundredYear"`   // 8/31/2023 -> 45168
+	MonthStartHundredYear   string          `json:"MonthStartHundredYear"` // 8/1/2023 -> 45138
+	Quarter                 int             `json:"Quarter"`               // 1-4
+	QuarterEndHundredYear   string          `json:"QuarterEndHundredYear"`
+	QuarterStartHundredYear string          `json:"QuarterStartHundredYear"`
+	Representable           map[string]bool `json:"Representable"` // AcscUsaStandard -> false outside 1940-2039
+	Sentinel                string          `json:"Sentinel"`      // *LOVAL, *HIVAL or *ZEROS instead of a date
+	UsWeekOfYear            int             `json:"UsWeekOfYear"`  // Sunday start, week 1 holds January 1
+	UsaStandard             string          `json:"UsaStandard"`   // 7/15/2023
+	WeekOfMonth             int             `json:"WeekOfMonth"`   // Sunday start, week 1 holds the 1st
 }
@@ -0,0 +1,12 @@
+package models
+
+type OutputSystemDate struct {
+	ErrorFlag      string `json:"ErrorFlag"`
+	ErrorText      string `json:"ErrorText"`
+	JobDate        string `json:"JobDate"`        // UDATE: 08312023
+	JobDateChanged bool   `json:"JobDateChanged"` // differs from the system date by request
+	Session        string `json:"Session"`
+	SystemDate     string `json:"SystemDate"` // SYSDATE: 08312023
+	SystemTime     string `json:"SystemTime"` // 14:05:09
+	TimeZone       string `json:"TimeZone"`   // America/Chicago
+}
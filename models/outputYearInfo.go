@@ -0,0 +1,22 @@
+package models
+
+type OutputYearInfo struct {
+	Calendar          string        `json:"Calendar"`          // US
+	Days              int           `json:"Days"`              // 365 or 366
+	EasterDate        string        `json:"EasterDate"`        // 2023-04-09
+	EasterHundredYear string        `json:"EasterHundredYear"` // 45025
+	ErrorFlag         string        `json:"ErrorFlag"`
+	ErrorText         string        `json:"ErrorText"`
+	FirstDayName      string        `json:"FirstDayName"`     // Sunday
+	FirstDayOfWeek    string        `json:"FirstDayOfWeek"`   // SUN.
+	FirstHundredYear  string        `json:"FirstHundredYear"` // 1/1/2023 -> 44926
+	FirstJulian       string        `json:"FirstJulian"`      // 23-001
+	FirstLongJulian   string        `json:"FirstLongJulian"`  // 2023001
+	Holidays          []YearHoliday `json:"Holidays"`
+	IsoWeeks          int           `json:"IsoWeeks"`        // 52 or 53
+	LastHundredYear   string        `json:"LastHundredYear"` // 12/31/2023 -> 45290
+	LastJulian        string        `json:"LastJulian"`      // 23-365
+	LastLongJulian    string        `json:"LastLongJulian"`  // 2023365
+	LeapYear          bool          `json:"LeapYear"`
+	Year              int           `json:"Year"`
+}
@@ -0,0 +1,7 @@
+package models
+
+type WindowOptions struct {
+	WindowPolicy string `json:"windowPolicy"` // *IBMI (1940-2039), *FIXED or *SLIDING
+	WindowStart  int    `json:"windowStart"`  // *FIXED: first year of the window, e.g. 1950
+	WindowYears  int    `json:"windowYears"`  // *SLIDING: years the window reaches back from today
+}
@@ -0,0 +1,9 @@
+package models
+
+type YearHoliday struct {
+	Actual      string `json:"Actual"`      // 2021-07-04 when observed on 2021-07-05
+	Date        string `json:"Date"`        // day off: 2021-07-05
+	DayOfWeek   string `json:"DayOfWeek"`   // MON.
+	HundredYear string `json:"HundredYear"` // 44381
+	Name        string `json:"Name"`        // Independence Day (observed)
+}
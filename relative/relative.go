@@ -0,0 +1,477 @@
+// Package relative reads dates written relative to a reference day, such as
+// "tomorrow", "EOM", "third Friday of next month", "last business day of Q3",
+// "+30" or "HYD 44926 + 10".
+package relative
+
+import (
+	"date_calculation/holiday"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Parser resolves expressions against Anchor, normally today. ReadDate, when
+// set, reads an explicit date used as the base of an offset such as
+// "2023-08-31 + 10"; ReadHundredYear, when set, reads the n of "HYD n".
+type Parser struct {
+	Anchor          time.Time
+	Calendar        *holiday.Calendar
+	Weekend         holiday.Weekend
+	ReadDate        func(string) (time.Time, error)
+	ReadHundredYear func(int) (time.Time, error)
+	Limits          Limits
+}
+
+// Limits bounds the offsets of one expression: days and weeks by Days,
+// months and years by Months, and all business days together by
+// BusinessDays. A zero limit leaves that unit unbounded.
+type Limits struct {
+	Days         int
+	Months       int
+	BusinessDays int
+}
+
+// Result is a resolved date with the reading it was given, written out in
+// full so a caller can check what was understood
+type Result struct {
+	Date           time.Time
+	Interpretation string
+}
+
+type offset struct {
+	amount int
+	unit   string
+}
+
+type period struct {
+	start time.Time
+	end   time.Time
+	label string
+}
+
+const unitPattern = `(business days?|bd|days?|d|weeks?|wks?|w|months?|mos?|m|years?|yrs?|y)`
+
+var (
+	offsetPattern   = regexp.MustCompile(`^(?:(.*\S)\s+|(.*[a-z])|)([+-])\s*(\d+)\s*` + unitPattern + `?$`)
+	inPattern       = regexp.MustCompile(`^in (\d+) ` + unitPattern + `$`)
+	agoPattern      = regexp.MustCompile(`^(\d+) ` + unitPattern + ` (ago|from now|from today)$`)
+	hydPattern      = regexp.MustCompile(`^hyd:?\s*(-?\d+)$`)
+	weekdayPattern  = regexp.MustCompile(`^(?:(next|last|this) )?([a-z]+)$`)
+	dayOfPattern    = regexp.MustCompile(`^(first day|last day|start|end|beginning) of (.+)$`)
+	nthOfPattern    = regexp.MustCompile(`^([a-z0-9]+) (business day|working day|[a-z]+) of (.+)$`)
+	quarterPattern  = regexp.MustCompile(`^q([1-4])(?: (\d{4}))?$`)
+	monthPattern    = regexp.MustCompile(`^([a-z]+)(?: (\d{4}))?$`)
+	yearPattern     = regexp.MustCompile(`^(\d{4})$`)
+	relativePattern = regexp.MustCompile(`^(this|next|last|previous) (month|quarter|year)$`)
+)
+
+var ordinals = map[string]int{
+	"first": 1, "1st": 1,
+	"second": 2, "2nd": 2,
+	"third": 3, "3rd": 3,
+	"fourth": 4, "4th": 4,
+	"fifth": 5, "5th": 5,
+	"last": -1,
+}
+
+var ordinalNames = map[int]string{1: "first", 2: "second", 3: "third", 4: "fourth", 5: "fifth", -1: "last"}
+
+// Month-end style shorthands and the period and end they pick
+var shorthands = map[string]struct {
+	period string
+	end    bool
+}{
+	"eom": {"month", true}, "bom": {"month", false}, "som": {"month", false},
+	"eoq": {"quarter", true}, "boq": {"quarter", false}, "soq": {"quarter", false},
+	"eoy": {"year", true}, "boy": {"year", false}, "soy": {"year", false},
+}
+
+// Parse resolves an expression, ignoring case and extra spaces
+func (p Parser) Parse(text string) (Result, error) {
+	expression := strings.Join(strings.Fields(strings.ToLower(text)), " ")
+	if expression == "" {
+		return Result{}, errors.New("invalid date expression: empty")
+	}
+
+	if match := inPattern.FindStringSubmatch(expression); match != nil {
+		expression = "+" + match[1] + " " + match[2]
+	} else if match := agoPattern.FindStringSubmatch(expression); match != nil {
+		sign := "+"
+		if match[3] == "ago" {
+			sign = "-"
+		}
+		expression = sign + match[1] + " " + match[2]
+	}
+
+	// A HYD base keeps its own sign, so "HYD -5" is day -5 and not HYD - 5
+	var offsets []offset
+	base := expression
+	for !hydPattern.MatchString(base) {
+		match := offsetPattern.FindStringSubmatch(base)
+		if match == nil {
+			break
+		}
+
+		amount, err := strconv.Atoi(match[4])
+		if err != nil {
+			return Result{}, errors.New("invalid date expression: " + text)
+		}
+		if match[3] == "-" {
+			amount = -amount
+		}
+
+		offsets = append([]offset{{amount: amount, unit: normalizeUnit(match[5])}}, offsets...)
+		base = match[1] + match[2]
+	}
+
+	if err := p.checkOffsets(offsets); err != nil {
+		return Result{}, err
+	}
+
+	date, interpretation, err := p.base(strings.TrimSpace(base), len(offsets) > 0)
+	if err != nil {
+		if err == errUnrecognized {
+			return Result{}, errors.New("invalid date expression: " + text)
+		}
+		return Result{}, err
+	}
+
+	for _, o := range offsets {
+		date = p.apply(date, o)
+		interpretation += " " + o.String()
+	}
+
+	if date.Year() < 1 || date.Year() > 9999 {
+		return Result{}, errors.New("result out of range: must be between 0001-01-01 and 9999-12-31")
+	}
+
+	return Result{Date: date, Interpretation: interpretation}, nil
+}
+
+var errUnrecognized = errors.New("unrecognized")
+
+// Resolves the part of an expression before any offsets. A plain date only
+// counts as a base when offsets follow it.
+func (p Parser) base(base string, hasOffsets bool) (time.Time, string, error) {
+	anchor := time.Date(p.Anchor.Year(), p.Anchor.Month(), p.Anchor.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch base {
+	case "":
+		if !hasOffsets {
+			return time.Time{}, "", errUnrecognized
+		}
+		return anchor, "today", nil
+	case "today", "now":
+		return anchor, "today", nil
+	case "tomorrow":
+		return anchor.AddDate(0, 0, 1), "tomorrow", nil
+	case "yesterday":
+		return anchor.AddDate(0, 0, -1), "yesterday", nil
+	}
+
+	if shorthand, ok := shorthands[base]; ok {
+		current, _ := p.period("this " + shorthand.period)
+		if shorthand.end {
+			return current.end, "last day of " + current.label, nil
+		}
+		return current.start, "first day of " + current.label, nil
+	}
+
+	if match := hydPattern.FindStringSubmatch(base); match != nil && p.ReadHundredYear != nil {
+		hundredYear, err := strconv.Atoi(match[1])
+		if err != nil {
+			return time.Time{}, "", errUnrecognized
+		}
+		date, err := p.ReadHundredYear(hundredYear)
+		if err != nil {
+			return time.Time{}, "", err
+		}
+		return date, "HYD " + match[1], nil
+	}
+
+	if match := weekdayPattern.FindStringSubmatch(base); match != nil {
+		if weekday, ok := holiday.ParseWeekday(match[2]); ok && isWeekdayName(match[2]) {
+			return weekdayFrom(anchor, weekday, match[1])
+		}
+	}
+
+	if match := dayOfPattern.FindStringSubmatch(base); match != nil {
+		target, err := p.period(match[2])
+		if err != nil {
+			return time.Time{}, "", err
+		}
+		if match[1] == "last day" || match[1] == "end" {
+			return target.end, "last day of " + target.label, nil
+		}
+		return target.start, "first day of " + target.label, nil
+	}
+
+	if match := nthOfPattern.FindStringSubmatch(base); match != nil {
+		n, ok := ordinals[match[1]]
+		if !ok {
+			return time.Time{}, "", errUnrecognized
+		}
+
+		target, err := p.period(match[3])
+		if err != nil {
+			return time.Time{}, "", err
+		}
+
+		if match[2] == "business day" || match[2] == "working day" {
+			return p.nthBusinessDay(target, n)
+		}
+
+		weekday, ok := holiday.ParseWeekday(match[2])
+		if !ok || !isWeekdayName(match[2]) {
+			return time.Time{}, "", errUnrecognized
+		}
+		return nthWeekday(target, weekday, n)
+	}
+
+	if hasOffsets && p.ReadDate != nil {
+		date, err := p.ReadDate(base)
+		if err != nil {
+			return time.Time{}, "", err
+		}
+		return date, date.Format("2006-01-02"), nil
+	}
+
+	return time.Time{}, "", errUnrecognized
+}
+
+func isWeekdayName(name string) bool {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		full := strings.ToLower(weekday.String())
+		if name == full || name == full[:3] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// A bare or "this" weekday is the next one on or after the anchor; "next"
+// and "last" never pick the anchor itself
+func weekdayFrom(anchor time.Time, weekday time.Weekday, which string) (time.Time, string, error) {
+	ahead := (int(weekday) - int(anchor.Weekday()) + 7) % 7
+
+	switch which {
+	case "next":
+		if ahead == 0 {
+			ahead = 7
+		}
+		return anchor.AddDate(0, 0, ahead), "next " + weekday.String(), nil
+	case "last":
+		behind := (int(anchor.Weekday()) - int(weekday) + 7) % 7
+		if behind == 0 {
+			behind = 7
+		}
+		return anchor.AddDate(0, 0, -behind), "last " + weekday.String(), nil
+	}
+
+	return anchor.AddDate(0, 0, ahead), weekday.String(), nil
+}
+
+func nthWeekday(target period, weekday time.Weekday, n int) (time.Time, string, error) {
+	description := ordinalNames[n] + " " + weekday.String() + " of " + target.label
+
+	var date time.Time
+	if n > 0 {
+		date = target.start.AddDate(0, 0, (int(weekday)-int(target.start.Weekday())+7)%7+(n-1)*7)
+	} else {
+		date = target.end.AddDate(0, 0, -((int(target.end.Weekday()) - int(weekday) + 7) % 7))
+	}
+
+	if date.After(target.end) {
+		return time.Time{}, "", errors.New("invalid date expression: there is no " + description)
+	}
+
+	return date, description, nil
+}
+
+func (p Parser) nthBusinessDay(target period, n int) (time.Time, string, error) {
+	description := ordinalNames[n] + " business day of " + target.label
+
+	date, step, count := target.start, 1, n
+	if n < 0 {
+		date, step, count = target.end, -1, -n
+	}
+
+	for ; !date.Before(target.start) && !date.After(target.end); date = date.AddDate(0, 0, step) {
+		if p.Calendar.IsBusinessDay(date, p.Weekend) {
+			count--
+			if count == 0 {
+				return date, description, nil
+			}
+		}
+	}
+
+	return time.Time{}, "", errors.New("invalid date expression: there is no " + description)
+}
+
+// Reads "next month", "Q3", "Q3 2024", "September", "sep 2023", "2024"...
+func (p Parser) period(text string) (period, error) {
+	year, month := p.Anchor.Year(), p.Anchor.Month()
+
+	// "the month" is the anchor's month
+	if rest, ok := strings.CutPrefix(text, "the "); ok {
+		text = "this " + rest
+	}
+
+	if match := relativePattern.FindStringSubmatch(text); match != nil {
+		shift := 0
+		switch match[1] {
+		case "next":
+			shift = 1
+		case "last", "previous":
+			shift = -1
+		}
+
+		switch match[2] {
+		case "month":
+			return monthPeriod(year, month+time.Month(shift)), nil
+		case "quarter":
+			return quarterPeriod(year, int(month-1)/3+1+shift), nil
+		}
+		return yearPeriod(year + shift), nil
+	}
+
+	if match := quarterPattern.FindStringSubmatch(text); match != nil {
+		quarter, _ := strconv.Atoi(match[1])
+		if match[2] != "" {
+			year, _ = strconv.Atoi(match[2])
+		}
+		return quarterPeriod(year, quarter), nil
+	}
+
+	if match := yearPattern.FindStringSubmatch(text); match != nil {
+		year, _ = strconv.Atoi(match[1])
+		return yearPeriod(year), nil
+	}
+
+	if match := monthPattern.FindStringSubmatch(text); match != nil {
+		if named, ok := monthNamed(match[1]); ok {
+			if match[2] != "" {
+				year, _ = strconv.Atoi(match[2])
+			}
+			return monthPeriod(year, named), nil
+		}
+	}
+
+	return period{}, errors.New("invalid date expression: unrecognized period: " + text)
+}
+
+func monthNamed(name string) (time.Month, bool) {
+	for month := time.January; month <= time.December; month++ {
+		full := strings.ToLower(month.String())
+		if name == full || name == full[:3] {
+			return month, true
+		}
+	}
+
+	return 0, false
+}
+
+func monthPeriod(year int, month time.Month) period {
+	start := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return period{start: start, end: start.AddDate(0, 1, -1), label: start.Format("January 2006")}
+}
+
+// Quarters outside 1-4 carry into the neighbouring years
+func quarterPeriod(year int, quarter int) period {
+	start := time.Date(year, time.Month((quarter-1)*3+1), 1, 0, 0, 0, 0, time.UTC)
+	return period{
+		start: start,
+		end:   start.AddDate(0, 3, -1),
+		label: fmt.Sprintf("Q%d %d", int(start.Month()-1)/3+1, start.Year()),
+	}
+}
+
+func yearPeriod(year int) period {
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return period{start: start, end: start.AddDate(1, 0, -1), label: strconv.Itoa(year)}
+}
+
+func normalizeUnit(unit string) string {
+	switch {
+	case unit == "" || unit == "d" || strings.HasPrefix(unit, "day"):
+		return "day"
+	case unit == "bd" || strings.HasPrefix(unit, "business"):
+		return "business day"
+	case strings.HasPrefix(unit, "w"):
+		return "week"
+	case unit == "m" || strings.HasPrefix(unit, "mo"):
+		return "month"
+	}
+
+	return "year"
+}
+
+// Offsets past the limits are rejected before any is applied, so none can
+// overflow or walk business days without end
+func (p Parser) checkOffsets(offsets []offset) error {
+	businessDays := 0
+	for _, o := range offsets {
+		amount := max(o.amount, -o.amount)
+
+		switch o.unit {
+		case "business day":
+			businessDays += amount
+			if p.Limits.BusinessDays > 0 && businessDays > p.Limits.BusinessDays {
+				return errors.New("offset out of range: must be at most " + strconv.Itoa(p.Limits.BusinessDays) + " business days")
+			}
+		case "day", "week":
+			if p.Limits.Days > 0 && (amount > p.Limits.Days || (o.unit == "week" && amount > p.Limits.Days/7)) {
+				return errors.New("offset out of range: must be at most " + strconv.Itoa(p.Limits.Days) + " days")
+			}
+		case "month", "year":
+			if p.Limits.Months > 0 && (amount > p.Limits.Months || (o.unit == "year" && amount > p.Limits.Months/12)) {
+				return errors.New("offset out of range: must be at most " + strconv.Itoa(p.Limits.Months) + " months")
+			}
+		}
+	}
+
+	return nil
+}
+
+// Months and years keep the day of month, using the month end when the
+// target month is shorter
+func (p Parser) apply(date time.Time, o offset) time.Time {
+	switch o.unit {
+	case "business day":
+		date, _ = p.Calendar.AddBusinessDays(date, o.amount, p.Weekend)
+		return date
+	case "week":
+		return date.AddDate(0, 0, o.amount*7)
+	case "month":
+		return addMonths(date, o.amount)
+	case "year":
+		return addMonths(date, o.amount*12)
+	}
+
+	return date.AddDate(0, 0, o.amount)
+}
+
+func addMonths(date time.Time, months int) time.Time {
+	target := time.Date(date.Year(), date.Month()+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	lastDay := target.AddDate(0, 1, -1).Day()
+
+	return time.Date(target.Year(), target.Month(), min(date.Day(), lastDay), 0, 0, 0, 0, time.UTC)
+}
+
+func (o offset) String() string {
+	sign, amount := "+", o.amount
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+
+	unit := o.unit
+	if amount != 1 {
+		unit += "s"
+	}
+
+	return sign + " " + strconv.Itoa(amount) + " " + unit
+}
@@ -0,0 +1,107 @@
+package relative
+
+import (
+	"date_calculation/holiday"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+var parser = Parser{
+	Anchor:   date(2023, time.August, 31),
+	Calendar: holiday.US,
+	Weekend:  holiday.SaturdaySunday,
+	ReadDate: func(text string) (time.Time, error) {
+		parsedDate, err := time.Parse("2006-01-02", text)
+		if err != nil {
+			return time.Time{}, errors.New("invalid date: " + text)
+		}
+		return parsedDate, nil
+	},
+	ReadHundredYear: func(hundredYear int) (time.Time, error) {
+		if hundredYear < -693594 || hundredYear > 2958464 {
+			return time.Time{}, errors.New("100 year date out of range")
+		}
+		return date(1899, time.December, 31).AddDate(0, 0, hundredYear), nil
+	},
+	Limits: Limits{Days: 3660000, Months: 120000, BusinessDays: 100000},
+}
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		expression             string
+		expectedDate           time.Time
+		expectedInterpretation string
+	}{
+		{"today", date(2023, time.August, 31), "today"},
+		{"Tomorrow", date(2023, time.September, 1), "tomorrow"},
+		{"EOM", date(2023, time.August, 31), "last day of August 2023"},
+		{"eom+1", date(2023, time.September, 1), "last day of August 2023 + 1 day"},
+		{"EOQ", date(2023, time.September, 30), "last day of Q3 2023"},
+		{"boy", date(2023, time.January, 1), "first day of 2023"},
+		{"third Friday of next month", date(2023, time.September, 15), "third Friday of September 2023"},
+		{"last friday of the month", date(2023, time.August, 25), "last Friday of August 2023"},
+		{"last business day of Q3", date(2023, time.September, 29), "last business day of Q3 2023"},
+		{"1st business day of january 2024", date(2024, time.January, 2), "first business day of January 2024"},
+		{"last day of feb 2024", date(2024, time.February, 29), "last day of February 2024"},
+		{"start of next quarter", date(2023, time.October, 1), "first day of Q4 2023"},
+		{"+30", date(2023, time.September, 30), "today + 30 days"},
+		{"-1w", date(2023, time.August, 24), "today - 1 week"},
+		{"today + 1 month", date(2023, time.September, 30), "today + 1 month"},
+		{"HYD 44926 + 10", date(2023, time.January, 11), "HYD 44926 + 10 days"},
+		{"hyd -5", date(1899, time.December, 26), "HYD -5"},
+		{"HYD -5 + 1", date(1899, time.December, 27), "HYD -5 + 1 day"},
+		{"HYD 44926 - 5", date(2022, time.December, 27), "HYD 44926 - 5 days"},
+		{"next friday", date(2023, time.September, 1), "next Friday"},
+		{"last thursday", date(2023, time.August, 24), "last Thursday"},
+		{"thursday", date(2023, time.August, 31), "Thursday"},
+		{"in 3 business days", date(2023, time.September, 6), "today + 3 business days"},
+		{"2 weeks ago", date(2023, time.August, 17), "today - 2 weeks"},
+		{"2023-01-31 + 1m", date(2023, time.February, 28), "2023-01-31 + 1 month"},
+		{"start of q4 2023 + 1y", date(2024, time.October, 1), "first day of Q4 2023 + 1 year"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expression, func(t *testing.T) {
+			result, err := parser.Parse(tc.expression)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedDate, result.Date)
+			assert.Equal(t, tc.expectedInterpretation, result.Interpretation)
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	testCases := []struct {
+		expression    string
+		expectedError string
+	}{
+		{"", "invalid date expression: empty"},
+		{"banana", "invalid date expression: banana"},
+		{"2023-08-31", "invalid date expression: 2023-08-31"},
+		{"8/31/2023 + 1", "invalid date: 8/31/2023"},
+		{"fifth friday of february 2023", "invalid date expression: there is no fifth Friday of February 2023"},
+		{"first monday of smarch", "invalid date expression: unrecognized period: smarch"},
+		{"HYD 2958464 + 1", "result out of range: must be between 0001-01-01 and 9999-12-31"},
+		{"HYD 9223372036854775807", "100 year date out of range"},
+		{"+9223372036854775807 days", "offset out of range: must be at most 3660000 days"},
+		{"+1317624576693539401 weeks", "offset out of range: must be at most 3660000 days"},
+		{"+9223372036854775807 months", "offset out of range: must be at most 120000 months"},
+		{"+768614336404564650 years", "offset out of range: must be at most 120000 months"},
+		{"+200000 bd", "offset out of range: must be at most 100000 business days"},
+		{"+60000 bd - 60000 bd", "offset out of range: must be at most 100000 business days"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expression, func(t *testing.T) {
+			_, err := parser.Parse(tc.expression)
+			assert.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
@@ -0,0 +1,411 @@
+// Package rrule expands RFC 5545 recurrence rules into dates. Only the date
+// is used; times of day are ignored.
+package rrule
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequencies
+const (
+	Daily   = "DAILY"
+	Weekly  = "WEEKLY"
+	Monthly = "MONTHLY"
+	Yearly  = "YEARLY"
+)
+
+// MaxOccurrences caps every expansion, whatever COUNT or UNTIL ask for
+const MaxOccurrences = 1000
+
+var weekdayCodes = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+var (
+	byDayPattern = regexp.MustCompile(`^([+-]?\d{1,2})?(SU|MO|TU|WE|TH|FR|SA)$`)
+	untilPattern = regexp.MustCompile(`^(\d{8})(T\d{6}Z?)?$`)
+)
+
+// WeekdayNum is a BYDAY entry: a weekday, and with N the Nth one (negative
+// counts from the end) of the month or year
+type WeekdayNum struct {
+	N       int
+	Weekday time.Weekday
+}
+
+// Rule is a parsed RRULE
+type Rule struct {
+	Freq       string
+	Interval   int
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByMonth    []int
+	BySetPos   []int
+	Count      int
+	Until      time.Time
+	WeekStart  time.Weekday
+}
+
+// Parse reads an RRULE value such as "FREQ=MONTHLY;BYDAY=-1FR;COUNT=6",
+// with or without the "RRULE:" prefix
+func Parse(text string) (Rule, error) {
+	rule := Rule{Interval: 1, WeekStart: time.Monday}
+
+	text = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(text)), "RRULE:")
+	if text == "" {
+		return rule, errors.New("invalid rrule: empty")
+	}
+
+	for _, part := range strings.Split(text, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return rule, errors.New("invalid rrule: " + part)
+		}
+
+		var err error
+		switch name {
+		case "FREQ":
+			if value != Daily && value != Weekly && value != Monthly && value != Yearly {
+				return rule, errors.New("invalid rrule: FREQ must be DAILY, WEEKLY, MONTHLY or YEARLY")
+			}
+			rule.Freq = value
+		case "INTERVAL":
+			rule.Interval, err = strconv.Atoi(value)
+			if err != nil || rule.Interval < 1 {
+				return rule, errors.New("invalid rrule: INTERVAL must be a positive number")
+			}
+		case "COUNT":
+			rule.Count, err = strconv.Atoi(value)
+			if err != nil || rule.Count < 1 {
+				return rule, errors.New("invalid rrule: COUNT must be a positive number")
+			}
+		case "UNTIL":
+			match := untilPattern.FindStringSubmatch(value)
+			if match == nil {
+				return rule, errors.New("invalid rrule: UNTIL must be a date such as 20231231")
+			}
+			rule.Until, err = time.Parse("20060102", match[1])
+			if err != nil {
+				return rule, errors.New("invalid rrule: UNTIL must be a date such as 20231231")
+			}
+		case "BYDAY":
+			for _, code := range strings.Split(value, ",") {
+				match := byDayPattern.FindStringSubmatch(code)
+				if match == nil {
+					return rule, errors.New("invalid rrule: BYDAY " + code)
+				}
+				n, _ := strconv.Atoi(strings.TrimPrefix(match[1], "+"))
+				if n < -53 || n > 53 || (match[1] != "" && n == 0) {
+					return rule, errors.New("invalid rrule: BYDAY " + code)
+				}
+				rule.ByDay = append(rule.ByDay, WeekdayNum{N: n, Weekday: weekdayCodes[match[2]]})
+			}
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseList(name, value, 31)
+		case "BYMONTH":
+			rule.ByMonth, err = parseList(name, value, 12)
+			for _, month := range rule.ByMonth {
+				if month < 0 {
+					err = errors.New("invalid rrule: BYMONTH must list months between 1 and 12")
+				}
+			}
+		case "BYSETPOS":
+			rule.BySetPos, err = parseList(name, value, 366)
+		case "WKST":
+			weekday, ok := weekdayCodes[value]
+			if !ok {
+				return rule, errors.New("invalid rrule: WKST " + value)
+			}
+			rule.WeekStart = weekday
+		default:
+			return rule, errors.New("invalid rrule: unsupported part " + name)
+		}
+
+		if err != nil {
+			return rule, err
+		}
+	}
+
+	if rule.Freq == "" {
+		return rule, errors.New("invalid rrule: FREQ is required")
+	}
+
+	if rule.Count != 0 && !rule.Until.IsZero() {
+		return rule, errors.New("invalid rrule: COUNT and UNTIL cannot both be given")
+	}
+
+	for _, day := range rule.ByDay {
+		if day.N != 0 && rule.Freq != Monthly && rule.Freq != Yearly {
+			return rule, errors.New("invalid rrule: numbered BYDAY needs FREQ=MONTHLY or YEARLY")
+		}
+	}
+
+	if len(rule.ByMonthDay) > 0 && rule.Freq == Weekly {
+		return rule, errors.New("invalid rrule: BYMONTHDAY cannot be used with FREQ=WEEKLY")
+	}
+
+	return rule, nil
+}
+
+// Comma separated non-zero numbers within ±limit
+func parseList(name string, value string, limit int) ([]int, error) {
+	var numbers []int
+	for _, field := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(strings.TrimPrefix(field, "+"))
+		if err != nil || n == 0 || n < -limit || n > limit {
+			return nil, errors.New("invalid rrule: " + name + " must list non-zero numbers between -" +
+				strconv.Itoa(limit) + " and " + strconv.Itoa(limit))
+		}
+		numbers = append(numbers, n)
+	}
+
+	return numbers, nil
+}
+
+// Expand lists the occurrences from start in date order, leaving out
+// exdates, up to limit (at most MaxOccurrences). truncated reports that the
+// rule had more occurrences than were returned.
+func (r Rule) Expand(start time.Time, exdates []time.Time, limit int) (occurrences []time.Time, truncated bool) {
+	start = dateOnly(start)
+	if limit <= 0 || limit > MaxOccurrences {
+		limit = MaxOccurrences
+	}
+
+	excluded := map[time.Time]bool{}
+	for _, exdate := range exdates {
+		excluded[dateOnly(exdate)] = true
+	}
+
+	counted := 0
+	for k := 0; ; k++ {
+		candidates, ok := r.period(start, k)
+		if !ok {
+			return occurrences, false
+		}
+
+		for _, date := range candidates {
+			if date.Before(start) {
+				continue
+			}
+			if !r.Until.IsZero() && date.After(r.Until) {
+				return occurrences, false
+			}
+			if r.Count != 0 && counted == r.Count {
+				return occurrences, false
+			}
+
+			// EXDATE removes occurrences without giving COUNT more
+			counted++
+			if excluded[date] {
+				continue
+			}
+			if len(occurrences) == limit {
+				return occurrences, true
+			}
+			occurrences = append(occurrences, date)
+		}
+	}
+}
+
+func dateOnly(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// The sorted, BYSETPOS filtered candidates of the kth period after start;
+// false once the period runs past 9999
+func (r Rule) period(start time.Time, k int) ([]time.Time, bool) {
+	var candidates []time.Time
+
+	switch r.Freq {
+	case Daily:
+		day := start.AddDate(0, 0, k*r.Interval)
+		if day.Year() > 9999 {
+			return nil, false
+		}
+		if r.matchesMonth(day.Month()) && r.matchesMonthDay(day) && r.matchesWeekday(day) {
+			candidates = append(candidates, day)
+		}
+	case Weekly:
+		weekStart := start.AddDate(0, 0, -((int(start.Weekday())-int(r.WeekStart)+7)%7)+k*7*r.Interval)
+		if weekStart.Year() > 9999 {
+			return nil, false
+		}
+		for i := 0; i < 7; i++ {
+			day := weekStart.AddDate(0, 0, i)
+			if day.Year() > 9999 || !r.matchesMonth(day.Month()) {
+				continue
+			}
+			if len(r.ByDay) == 0 {
+				if day.Weekday() == start.Weekday() {
+					candidates = append(candidates, day)
+				}
+			} else if r.matchesWeekday(day) {
+				candidates = append(candidates, day)
+			}
+		}
+	case Monthly:
+		month := time.Date(start.Year(), start.Month()+time.Month(k*r.Interval), 1, 0, 0, 0, 0, time.UTC)
+		if month.Year() > 9999 {
+			return nil, false
+		}
+		if r.matchesMonth(month.Month()) {
+			candidates = r.monthDays(month, start)
+		}
+	case Yearly:
+		year := start.Year() + k*r.Interval
+		if year > 9999 {
+			return nil, false
+		}
+		candidates = r.yearDays(year, start)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].Before(candidates[j]) })
+
+	return r.setPositions(candidates), true
+}
+
+// Days of a month picked by BYMONTHDAY and BYDAY, or start's day of month
+func (r Rule) monthDays(month time.Time, start time.Time) []time.Time {
+	lastDay := month.AddDate(0, 1, -1).Day()
+
+	var days []time.Time
+	for day := 1; day <= lastDay; day++ {
+		date := time.Date(month.Year(), month.Month(), day, 0, 0, 0, 0, time.UTC)
+
+		if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+			if day == start.Day() {
+				days = append(days, date)
+			}
+			continue
+		}
+
+		if r.matchesMonthDay(date) && r.matchesNthWeekday(date, month, month.AddDate(0, 1, -1)) {
+			days = append(days, date)
+		}
+	}
+
+	return days
+}
+
+// BYDAY without BYMONTH or BYMONTHDAY numbers weekdays through the year;
+// otherwise each month is expanded on its own
+func (r Rule) yearDays(year int, start time.Time) []time.Time {
+	if len(r.ByDay) > 0 && len(r.ByMonth) == 0 && len(r.ByMonthDay) == 0 {
+		first := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		last := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+		var days []time.Time
+		for date := first; !date.After(last); date = date.AddDate(0, 0, 1) {
+			if r.matchesNthWeekday(date, first, last) {
+				days = append(days, date)
+			}
+		}
+		return days
+	}
+
+	// BYMONTHDAY alone picks its days in every month; otherwise the rule
+	// keeps to the start month
+	months := r.ByMonth
+	if len(months) == 0 && len(r.ByMonthDay) > 0 {
+		months = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+	} else if len(months) == 0 {
+		months = []int{int(start.Month())}
+	}
+
+	var days []time.Time
+	for _, month := range months {
+		days = append(days, r.monthDays(time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC), start)...)
+	}
+
+	return days
+}
+
+func (r Rule) matchesMonth(month time.Month) bool {
+	if len(r.ByMonth) == 0 {
+		return true
+	}
+
+	for _, m := range r.ByMonth {
+		if time.Month(m) == month {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r Rule) matchesMonthDay(date time.Time) bool {
+	if len(r.ByMonthDay) == 0 {
+		return true
+	}
+
+	lastDay := time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	for _, day := range r.ByMonthDay {
+		if day == date.Day() || (day < 0 && lastDay+day+1 == date.Day()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r Rule) matchesWeekday(date time.Time) bool {
+	for _, day := range r.ByDay {
+		if day.Weekday == date.Weekday() {
+			return true
+		}
+	}
+
+	return len(r.ByDay) == 0
+}
+
+// Numbered BYDAY entries count occurrences of the weekday from the first or
+// last day of the span
+func (r Rule) matchesNthWeekday(date time.Time, first time.Time, last time.Time) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+
+	for _, day := range r.ByDay {
+		if day.Weekday != date.Weekday() {
+			continue
+		}
+
+		fromStart := int(date.Sub(first).Hours()/24)/7 + 1
+		fromEnd := -(int(last.Sub(date).Hours()/24)/7 + 1)
+		if day.N == 0 || day.N == fromStart || day.N == fromEnd {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r Rule) setPositions(candidates []time.Time) []time.Time {
+	if len(r.BySetPos) == 0 {
+		return candidates
+	}
+
+	var picked []time.Time
+	for i, date := range candidates {
+		for _, position := range r.BySetPos {
+			if position == i+1 || position == i-len(candidates) {
+				picked = append(picked, date)
+				break
+			}
+		}
+	}
+
+	return picked
+}
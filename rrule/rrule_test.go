@@ -0,0 +1,173 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestExpand(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rule     string
+		start    time.Time
+		exdates  []time.Time
+		expected []time.Time
+	}{
+		{
+			name:     "Daily every other day",
+			rule:     "FREQ=DAILY;INTERVAL=2;COUNT=4",
+			start:    date(2023, time.December, 30),
+			expected: []time.Time{date(2023, time.December, 30), date(2024, time.January, 1), date(2024, time.January, 3), date(2024, time.January, 5)},
+		},
+		{
+			name:     "Weekly on the start weekday until a date",
+			rule:     "RRULE:FREQ=WEEKLY;UNTIL=20230829T235959Z",
+			start:    date(2023, time.August, 8),
+			expected: []time.Time{date(2023, time.August, 8), date(2023, time.August, 15), date(2023, time.August, 22), date(2023, time.August, 29)},
+		},
+		{
+			name:     "Biweekly on Tuesday and Thursday",
+			rule:     "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH;COUNT=5",
+			start:    date(2023, time.August, 8),
+			expected: []time.Time{date(2023, time.August, 8), date(2023, time.August, 10), date(2023, time.August, 22), date(2023, time.August, 24), date(2023, time.September, 5)},
+		},
+		{
+			name:     "Monthly on the 31st skips short months",
+			rule:     "FREQ=MONTHLY;COUNT=4",
+			start:    date(2023, time.January, 31),
+			expected: []time.Time{date(2023, time.January, 31), date(2023, time.March, 31), date(2023, time.May, 31), date(2023, time.July, 31)},
+		},
+		{
+			name:     "Last day of the month",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3",
+			start:    date(2024, time.January, 15),
+			expected: []time.Time{date(2024, time.January, 31), date(2024, time.February, 29), date(2024, time.March, 31)},
+		},
+		{
+			name:     "Last Friday of the month",
+			rule:     "FREQ=MONTHLY;BYDAY=-1FR;COUNT=3",
+			start:    date(2023, time.August, 1),
+			expected: []time.Time{date(2023, time.August, 25), date(2023, time.September, 29), date(2023, time.October, 27)},
+		},
+		{
+			name:     "Last weekday of the month",
+			rule:     "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=3",
+			start:    date(2023, time.September, 1),
+			expected: []time.Time{date(2023, time.September, 29), date(2023, time.October, 31), date(2023, time.November, 30)},
+		},
+		{
+			name:     "Friday the 13th",
+			rule:     "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13;COUNT=3",
+			start:    date(2023, time.January, 1),
+			expected: []time.Time{date(2023, time.January, 13), date(2023, time.October, 13), date(2024, time.September, 13)},
+		},
+		{
+			name:     "Thanksgiving",
+			rule:     "FREQ=YEARLY;BYMONTH=11;BYDAY=4TH;COUNT=2",
+			start:    date(2023, time.January, 1),
+			expected: []time.Time{date(2023, time.November, 23), date(2024, time.November, 28)},
+		},
+		{
+			name:     "Yearly numbered weekday of the year",
+			rule:     "FREQ=YEARLY;BYDAY=20MO;COUNT=2",
+			start:    date(2023, time.January, 1),
+			expected: []time.Time{date(2023, time.May, 15), date(2024, time.May, 13)},
+		},
+		{
+			name:     "Yearly month day without a month",
+			rule:     "FREQ=YEARLY;BYMONTHDAY=1;COUNT=13",
+			start:    date(2023, time.March, 15),
+			expected: []time.Time{date(2023, time.April, 1), date(2023, time.May, 1), date(2023, time.June, 1), date(2023, time.July, 1), date(2023, time.August, 1), date(2023, time.September, 1), date(2023, time.October, 1), date(2023, time.November, 1), date(2023, time.December, 1), date(2024, time.January, 1), date(2024, time.February, 1), date(2024, time.March, 1), date(2024, time.April, 1)},
+		},
+		{
+			name:     "Yearly Friday the 13th",
+			rule:     "FREQ=YEARLY;BYDAY=FR;BYMONTHDAY=13;COUNT=2",
+			start:    date(2023, time.January, 1),
+			expected: []time.Time{date(2023, time.January, 13), date(2023, time.October, 13)},
+		},
+		{
+			name:     "Leap day only in leap years",
+			rule:     "FREQ=YEARLY;COUNT=2",
+			start:    date(2024, time.February, 29),
+			expected: []time.Time{date(2024, time.February, 29), date(2028, time.February, 29)},
+		},
+		{
+			name:     "Excluded dates still count",
+			rule:     "FREQ=WEEKLY;COUNT=3",
+			start:    date(2023, time.August, 8),
+			exdates:  []time.Time{date(2023, time.August, 15)},
+			expected: []time.Time{date(2023, time.August, 8), date(2023, time.August, 22)},
+		},
+		{
+			name:     "Stops at the end of 9999",
+			rule:     "FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=30",
+			start:    date(9998, time.January, 1),
+			expected: []time.Time{date(9998, time.December, 30), date(9999, time.December, 30)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rule, err := Parse(tc.rule)
+			assert.NoError(t, err)
+
+			occurrences, truncated := rule.Expand(tc.start, tc.exdates, 0)
+			assert.Equal(t, tc.expected, occurrences)
+			assert.False(t, truncated)
+		})
+	}
+}
+
+func TestExpandLimit(t *testing.T) {
+	rule, err := Parse("FREQ=DAILY")
+	assert.NoError(t, err)
+
+	occurrences, truncated := rule.Expand(date(2023, time.January, 1), nil, 10)
+	assert.Len(t, occurrences, 10)
+	assert.True(t, truncated)
+
+	occurrences, truncated = rule.Expand(date(2023, time.January, 1), nil, MaxOccurrences+1)
+	assert.Len(t, occurrences, MaxOccurrences)
+	assert.True(t, truncated)
+
+	rule, err = Parse("FREQ=DAILY;COUNT=10")
+	assert.NoError(t, err)
+
+	occurrences, truncated = rule.Expand(date(2023, time.January, 1), nil, 10)
+	assert.Len(t, occurrences, 10)
+	assert.False(t, truncated)
+}
+
+func TestParseErrors(t *testing.T) {
+	testCases := []struct {
+		rule          string
+		expectedError string
+	}{
+		{"", "invalid rrule: empty"},
+		{"COUNT=3", "invalid rrule: FREQ is required"},
+		{"FREQ=HOURLY", "invalid rrule: FREQ must be DAILY, WEEKLY, MONTHLY or YEARLY"},
+		{"FREQ=DAILY;INTERVAL=0", "invalid rrule: INTERVAL must be a positive number"},
+		{"FREQ=DAILY;COUNT=2;UNTIL=20231231", "invalid rrule: COUNT and UNTIL cannot both be given"},
+		{"FREQ=DAILY;UNTIL=2023-12-31", "invalid rrule: UNTIL must be a date such as 20231231"},
+		{"FREQ=WEEKLY;BYDAY=1MO", "invalid rrule: numbered BYDAY needs FREQ=MONTHLY or YEARLY"},
+		{"FREQ=MONTHLY;BYDAY=XX", "invalid rrule: BYDAY XX"},
+		{"FREQ=MONTHLY;BYMONTHDAY=32", "invalid rrule: BYMONTHDAY must list non-zero numbers between -31 and 31"},
+		{"FREQ=YEARLY;BYMONTH=-1", "invalid rrule: BYMONTH must list months between 1 and 12"},
+		{"FREQ=WEEKLY;BYMONTHDAY=1", "invalid rrule: BYMONTHDAY cannot be used with FREQ=WEEKLY"},
+		{"FREQ=DAILY;BYHOUR=9", "invalid rrule: unsupported part BYHOUR"},
+		{"FREQ=DAILY;COUNT", "invalid rrule: COUNT"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.rule, func(t *testing.T) {
+			_, err := Parse(tc.rule)
+			assert.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+// Package terms reads invoice payment terms such as "NET 30", "EOM+10",
+// "MFI 15" or "2/10 NET 30" and works out the dates they fall due.
+package terms
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// What the days of a due date are counted from
+const (
+	// Invoice counts days from the invoice date
+	Invoice = "*INVOICE"
+	// MonthEnd counts days from the last day of the invoice month
+	MonthEnd = "*EOM"
+	// Proximo falls due on a fixed day of the month following the invoice
+	Proximo = "*PROX"
+)
+
+const (
+	maxDays = 999
+	// "2/10 EOM" leaves the net due this many days after the discount
+	eomNetDays = 20
+)
+
+// Due is one date of a term: days from Base, or the day of the month for
+// Proximo
+type Due struct {
+	Base string
+	Days int
+}
+
+// Terms is a parsed terms code. Discount is nil when no early payment
+// discount is offered.
+type Terms struct {
+	Percent  float64
+	Discount *Due
+	Net      Due
+}
+
+// Dates are the due dates of terms applied to one invoice; Discount is the
+// zero time when no discount is offered
+type Dates struct {
+	Discount time.Time
+	Net      time.Time
+}
+
+var (
+	discountPattern = regexp.MustCompile(`^(\d{1,2}(?:\.\d+)?)%?\s*/\s*(\d+)\s*(.*)$`)
+	netPattern      = regexp.MustCompile(`^(?:NET\s*|N)?(\d+)$`)
+	eomPattern      = regexp.MustCompile(`^(?:NET\s*)?EOM(?:\s*\+\s*(\d+))?$`)
+	daysEOMPattern  = regexp.MustCompile(`^(?:NET\s*)?(\d+)\s*EOM$`)
+	proximoPattern  = regexp.MustCompile(`^(?:MFI|PROX(?:IMO)?)\s*(\d+)(?:ST|ND|RD|TH)?$`)
+	dayProxPattern  = regexp.MustCompile(`^(\d+)(?:ST|ND|RD|TH)?\s*(?:MFI|PROX(?:IMO)?)$`)
+)
+
+// Parse reads a terms code, ignoring case and extra spaces
+func Parse(code string) (Terms, error) {
+	var terms Terms
+
+	text := strings.Join(strings.Fields(strings.ToUpper(code)), " ")
+	if text == "" {
+		return terms, errors.New("invalid terms: empty")
+	}
+
+	if match := discountPattern.FindStringSubmatch(text); match != nil {
+		percent, err := strconv.ParseFloat(match[1], 64)
+		if err != nil || percent <= 0 || percent >= 100 {
+			return terms, errors.New("invalid terms: discount must be between 0 and 100 percent")
+		}
+
+		days, _ := strconv.Atoi(match[2])
+		terms.Percent = percent
+		terms.Discount = &Due{Base: Invoice, Days: days}
+		text = strings.TrimSpace(match[3])
+
+		// "2/10 EOM": the discount runs from month end and net follows it
+		if text == "EOM" {
+			terms.Discount.Base = MonthEnd
+			terms.Net = Due{Base: MonthEnd, Days: days + eomNetDays}
+			return terms, terms.validate()
+		}
+	}
+
+	net, err := parseNet(text)
+	if err != nil {
+		return terms, err
+	}
+	terms.Net = net
+
+	return terms, terms.validate()
+}
+
+func parseNet(text string) (Due, error) {
+	if text == "" {
+		return Due{}, errors.New("invalid terms: missing net terms")
+	}
+
+	if match := netPattern.FindStringSubmatch(text); match != nil {
+		days, _ := strconv.Atoi(match[1])
+		return Due{Base: Invoice, Days: days}, nil
+	}
+
+	if match := eomPattern.FindStringSubmatch(text); match != nil {
+		days, _ := strconv.Atoi(match[1])
+		return Due{Base: MonthEnd, Days: days}, nil
+	}
+
+	if match := daysEOMPattern.FindStringSubmatch(text); match != nil {
+		days, _ := strconv.Atoi(match[1])
+		return Due{Base: MonthEnd, Days: days}, nil
+	}
+
+	for _, pattern := range []*regexp.Regexp{proximoPattern, dayProxPattern} {
+		if match := pattern.FindStringSubmatch(text); match != nil {
+			day, _ := strconv.Atoi(match[1])
+			return Due{Base: Proximo, Days: day}, nil
+		}
+	}
+
+	return Due{}, errors.New("invalid terms: unrecognized net terms: " + text)
+}
+
+func (t Terms) validate() error {
+	if t.Net.Base == Proximo && (t.Net.Days < 1 || t.Net.Days > 31) {
+		return errors.New("invalid terms: proximo day must be between 1 and 31")
+	}
+
+	if t.Net.Days > maxDays || (t.Discount != nil && t.Discount.Days > maxDays) {
+		return errors.New("invalid terms: days must be at most " + strconv.Itoa(maxDays))
+	}
+
+	// Both counted from the invoice, the discount cannot outlast net
+	if t.Discount != nil && t.Discount.Base == t.Net.Base && t.Discount.Days > t.Net.Days {
+		return errors.New("invalid terms: discount days must not exceed net days")
+	}
+
+	return nil
+}
+
+// Apply works out the due dates for an invoice
+func (t Terms) Apply(invoice time.Time) Dates {
+	dates := Dates{Net: t.Net.date(invoice)}
+	if t.Discount != nil {
+		dates.Discount = t.Discount.date(invoice)
+	}
+
+	return dates
+}
+
+func (d Due) date(invoice time.Time) time.Time {
+	year, month, day := invoice.Date()
+
+	switch d.Base {
+	case MonthEnd:
+		return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).AddDate(0, 0, d.Days)
+	case Proximo:
+		lastDay := time.Date(year, month+2, 0, 0, 0, 0, 0, time.UTC).Day()
+		return time.Date(year, month+1, min(d.Days, lastDay), 0, 0, 0, 0, time.UTC)
+	}
+
+	return time.Date(year, month, day+d.Days, 0, 0, 0, 0, time.UTC)
+}
+
+// String writes terms in a canonical form: "2/10 NET 30", "NET EOM+10",
+// "MFI 15" or "2/10 EOM"
+func (t Terms) String() string {
+	if t.Discount != nil && t.Discount.Base == MonthEnd {
+		return formatPercent(t.Percent) + "/" + strconv.Itoa(t.Discount.Days) + " EOM"
+	}
+
+	var net string
+	switch t.Net.Base {
+	case MonthEnd:
+		net = "NET EOM"
+		if t.Net.Days != 0 {
+			net += "+" + strconv.Itoa(t.Net.Days)
+		}
+	case Proximo:
+		net = "MFI " + strconv.Itoa(t.Net.Days)
+	default:
+		net = "NET " + strconv.Itoa(t.Net.Days)
+	}
+
+	if t.Discount == nil {
+		return net
+	}
+
+	return formatPercent(t.Percent) + "/" + strconv.Itoa(t.Discount.Days) + " " + net
+}
+
+func formatPercent(percent float64) string {
+	return strconv.FormatFloat(percent, 'f', -1, 64)
+}
@@ -0,0 +1,70 @@
+package terms
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestApply(t *testing.T) {
+	testCases := []struct {
+		code             string
+		invoice          time.Time
+		expectedTerms    string
+		expectedDiscount time.Time
+		expectedNet      time.Time
+		expectedPercent  float64
+	}{
+		{"Net 30", date(2023, time.August, 15), "NET 30", time.Time{}, date(2023, time.September, 14), 0},
+		{"n45", date(2023, time.December, 20), "NET 45", time.Time{}, date(2024, time.February, 3), 0},
+		{"EOM", date(2023, time.February, 10), "NET EOM", time.Time{}, date(2023, time.February, 28), 0},
+		{"eom + 10", date(2024, time.February, 10), "NET EOM+10", time.Time{}, date(2024, time.March, 10), 0},
+		{"Net 15 EOM", date(2023, time.January, 31), "NET EOM+15", time.Time{}, date(2023, time.February, 15), 0},
+		{"MFI 15", date(2023, time.August, 31), "MFI 15", time.Time{}, date(2023, time.September, 15), 0},
+		{"31st proximo", date(2023, time.January, 5), "MFI 31", time.Time{}, date(2023, time.February, 28), 0},
+		{"PROX 10", date(2023, time.December, 5), "MFI 10", time.Time{}, date(2024, time.January, 10), 0},
+		{"2/10 Net 30", date(2023, time.August, 15), "2/10 NET 30", date(2023, time.August, 25), date(2023, time.September, 14), 2},
+		{"1.5% / 15 n60", date(2023, time.August, 15), "1.5/15 NET 60", date(2023, time.August, 30), date(2023, time.October, 14), 1.5},
+		{"2/10 EOM", date(2023, time.August, 15), "2/10 EOM", date(2023, time.September, 10), date(2023, time.September, 30), 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.code, func(t *testing.T) {
+			terms, err := Parse(tc.code)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedTerms, terms.String())
+			assert.Equal(t, tc.expectedPercent, terms.Percent)
+
+			dates := terms.Apply(tc.invoice)
+			assert.Equal(t, tc.expectedDiscount, dates.Discount)
+			assert.Equal(t, tc.expectedNet, dates.Net)
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	testCases := []struct {
+		code          string
+		expectedError string
+	}{
+		{"", "invalid terms: empty"},
+		{"COD", "invalid terms: unrecognized net terms: COD"},
+		{"2/10", "invalid terms: missing net terms"},
+		{"0/10 NET 30", "invalid terms: discount must be between 0 and 100 percent"},
+		{"MFI 32", "invalid terms: proximo day must be between 1 and 31"},
+		{"NET 1000", "invalid terms: days must be at most 999"},
+		{"2/60 NET 30", "invalid terms: discount days must not exceed net days"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.code, func(t *testing.T) {
+			_, err := Parse(tc.code)
+			assert.EqualError(t, err, tc.expectedError)
+		})
+	}
+}